@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/sam-maryland/any-given-sunday/internal/app"
@@ -18,11 +20,12 @@ func main() {
 	}
 
 	var mode string
-	flag.StringVar(&mode, "mode", "", "Execution mode (weekly-recap)")
+	flag.StringVar(&mode, "mode", "", "Execution mode (weekly-recap, dues-reminders, season-awards, year-in-review, onboarding-reminders, transactions)")
 	flag.Parse()
 
-	if mode != "weekly-recap" {
-		log.Fatal("Invalid mode. Use --mode=weekly-recap")
+	validModes := []string{"weekly-recap", "dues-reminders", "season-awards", "year-in-review", "onboarding-reminders", "transactions"}
+	if !slices.Contains(validModes, mode) {
+		log.Fatalf("Invalid mode. Use --mode=%s", strings.Join(validModes, ", --mode="))
 	}
 
 	ctx := context.Background()
@@ -33,11 +36,44 @@ func main() {
 		log.Fatalf("Failed to initialize weekly recap app: %v", err)
 	}
 
-	// Run the weekly recap workflow
-	if err := application.RunWeeklyRecap(ctx); err != nil {
-		log.Fatalf("Weekly recap failed: %v", err)
+	switch mode {
+	case "weekly-recap":
+		// Run the weekly recap workflow
+		if err := application.RunWeeklyRecap(ctx); err != nil {
+			log.Fatalf("Weekly recap failed: %v", err)
+		}
+		fmt.Println("✅ Weekly recap completed successfully!")
+	case "dues-reminders":
+		// Remind members with outstanding buy-ins
+		if err := application.RunDuesReminders(ctx); err != nil {
+			log.Fatalf("Dues reminders failed: %v", err)
+		}
+		fmt.Println("✅ Dues reminders completed successfully!")
+	case "season-awards":
+		// Re-post the awards ceremony for the latest completed league
+		if err := application.RunLatestSeasonAwards(ctx); err != nil {
+			log.Fatalf("Season awards failed: %v", err)
+		}
+		fmt.Println("✅ Season awards completed successfully!")
+	case "year-in-review":
+		// Send year in review reports for the latest completed league
+		if err := application.RunLatestYearInReview(ctx); err != nil {
+			log.Fatalf("Year in review failed: %v", err)
+		}
+		fmt.Println("✅ Year in review completed successfully!")
+	case "onboarding-reminders":
+		// Remind members who haven't linked their Sleeper account
+		if err := application.RunOnboardingReminders(ctx); err != nil {
+			log.Fatalf("Onboarding reminders failed: %v", err)
+		}
+		fmt.Println("✅ Onboarding reminders completed successfully!")
+	case "transactions":
+		// Post new trades, waiver claims and free agent moves
+		if err := application.RunTransactions(ctx); err != nil {
+			log.Fatalf("Transactions failed: %v", err)
+		}
+		fmt.Println("✅ Transactions completed successfully!")
 	}
 
-	fmt.Println("✅ Weekly recap completed successfully!")
 	os.Exit(0)
 }
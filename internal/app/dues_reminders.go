@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+// RunDuesReminders reminds every member with an outstanding buy-in by Discord DM and email,
+// including the co-owners of their franchise. Reminders only go out for the latest league and stop
+// once its trade deadline has passed.
+func (a *WeeklyRecapApp) RunDuesReminders(ctx context.Context) error {
+	league, err := a.interactor.GetLatestLeague(ctx)
+	if err != nil {
+		log.Printf("No league found, skipping dues reminders: %v", err)
+		return nil
+	}
+
+	// Make sure every roster owner has a buy-in recorded, even before week 1
+	if err := a.interactor.RecordSeasonBuyIns(ctx, league.Year); err != nil {
+		return fmt.Errorf("failed to record season buy-ins: %w", err)
+	}
+
+	beforeDeadline, err := a.interactor.IsBeforeTradeDeadline(ctx, league.Year)
+	if err != nil {
+		return fmt.Errorf("failed to check trade deadline: %w", err)
+	}
+	if !beforeDeadline {
+		log.Printf("Trade deadline has passed for league year %d, skipping dues reminders", league.Year)
+		return nil
+	}
+
+	statuses, err := a.interactor.GetDuesStatus(ctx, league.Year)
+	if err != nil {
+		return fmt.Errorf("failed to get dues status: %w", err)
+	}
+
+	unpaid := statuses.Unpaid()
+	if len(unpaid) == 0 {
+		log.Printf("✅ Everyone has paid their %d dues, no reminders to send", league.Year)
+		return nil
+	}
+
+	franchises, err := a.interactor.GetFranchises(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get franchises: %w", err)
+	}
+
+	log.Printf("Sending dues reminders to %d members...", len(unpaid))
+	for _, status := range unpaid {
+		for _, manager := range franchises.ManagersOf(status.User()) {
+			a.sendDuesReminder(ctx, status.ForManager(manager), league.Year)
+		}
+	}
+
+	return nil
+}
+
+// sendDuesReminder reminds a member of their outstanding buy-in.
+// Reminders are optional, so errors are logged and don't fail the job.
+func (a *WeeklyRecapApp) sendDuesReminder(ctx context.Context, status domain.DuesStatus, year int) {
+	if a.directMessenger != nil && status.DiscordID != "" {
+		if err := a.directMessenger.SendDirectMessage(ctx, status.DiscordID, duesReminderMessage(status, year)); err != nil {
+			log.Printf("⚠️  Failed to DM dues reminder to %s: %v", status.UserName, err)
+		} else {
+			log.Printf("✅ Sent dues reminder DM to %s", status.UserName)
+		}
+	}
+
+	if a.emailClient != nil && status.Email != "" {
+		if err := a.emailClient.SendDuesReminder(ctx, status, year); err != nil {
+			log.Printf("⚠️  Failed to email dues reminder to %s: %v", status.UserName, err)
+		} else {
+			log.Printf("✅ Sent dues reminder email to %s", status.UserName)
+		}
+	}
+}
+
+// duesReminderMessage formats the Discord DM sent to a member with an outstanding buy-in
+func duesReminderMessage(status domain.DuesStatus, year int) string {
+	return fmt.Sprintf("👋 Hey %s! Friendly reminder that you still owe **$%d** for your %d Any Given Sunday buy-in. "+
+		"Please pay the commissioner before the trade deadline. Already paid? Let them know so they can mark it! 💸",
+		status.UserName, status.Outstanding(), year)
+}
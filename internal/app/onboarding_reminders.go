@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// RunOnboardingReminders reminds members who haven't linked their Sleeper account yet by Discord DM.
+// It also refreshes the accounts offered by open welcome messages and cleans up the messages of members
+// who have since linked an account or left the server.
+func (a *WeeklyRecapApp) RunOnboardingReminders(ctx context.Context) error {
+	if a.welcomeMessages == nil {
+		log.Println("Discord client not configured, skipping onboarding reminders")
+		return nil
+	}
+
+	if err := a.welcomeMessages.Refresh(ctx); err != nil {
+		log.Printf("⚠️  Failed to refresh welcome messages: %v", err)
+	}
+
+	if err := a.welcomeMessages.SendReminders(ctx, time.Now()); err != nil {
+		return fmt.Errorf("failed to send onboarding reminders: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/sam-maryland/any-given-sunday/internal/format"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+// RunLatestSeasonAwards re-posts the season awards for the latest league, if it is complete
+func (a *WeeklyRecapApp) RunLatestSeasonAwards(ctx context.Context) error {
+	league, err := a.interactor.GetLatestLeague(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest league: %w", err)
+	}
+
+	if league.Status != domain.LeagueStatusComplete {
+		log.Printf("League year %d has status '%s' (not COMPLETE), skipping season awards", league.Year, league.Status)
+		return nil
+	}
+
+	return a.RunSeasonAwards(ctx, league.Year)
+}
+
+// RunSeasonAwards posts the end-of-season awards ceremony for a completed league to Discord and by email
+func (a *WeeklyRecapApp) RunSeasonAwards(ctx context.Context, year int) error {
+	log.Printf("Generating season awards for year %d", year)
+	awards, err := a.interactor.GetSeasonAwards(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to generate season awards: %w", err)
+	}
+	log.Printf("✅ Season awards generated for year %d", year)
+
+	// Get users for name formatting
+	users, err := a.interactor.GetManagerNames(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+
+	// Post to Discord channel (optional, won't fail the job if it errors)
+	if a.channelPoster != nil {
+		log.Println("Posting season awards to Discord...")
+		if err := a.channelPoster.PostSeasonAwards(ctx, format.SeasonAwards(awards, users)); err != nil {
+			log.Printf("⚠️  Failed to post season awards to Discord: %v", err)
+		} else {
+			log.Println("✅ Season awards posted to Discord")
+		}
+	} else {
+		log.Println("Discord client not configured, skipping Discord notification")
+	}
+
+	// Send email notifications (optional, won't fail the job if it errors)
+	if a.emailClient != nil {
+		log.Println("Sending season awards emails...")
+		dbUsersWithEmail, err := a.queries.GetUsersWithEmail(ctx)
+		if err != nil {
+			log.Printf("⚠️  Failed to get users with email addresses: %v", err)
+			log.Println("Skipping email notifications")
+		} else if err := a.emailClient.SendSeasonAwards(ctx, awards, converters.UsersFromDB(dbUsersWithEmail), users); err != nil {
+			log.Printf("⚠️  Email sending encountered errors: %v", err)
+		} else {
+			log.Println("✅ Season awards emails sent successfully")
+		}
+	} else {
+		log.Println("Email client not configured, skipping email notifications")
+	}
+
+	return nil
+}
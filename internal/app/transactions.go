@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/internal/format"
+)
+
+// transactionPostWindow is how recent a transaction must be to be posted by the first run of a season. Older
+// transactions are marked posted without posting them, so the channel isn't flooded with every transaction so far.
+// Once the season's feed has started every transaction is posted, so none are lost if the job doesn't run for a while.
+const transactionPostWindow = 48 * time.Hour
+
+// RunTransactions syncs the latest league's transactions from Sleeper and posts new trades, waiver claims
+// and free agent moves to the transactions channel.
+func (a *WeeklyRecapApp) RunTransactions(ctx context.Context) error {
+	if a.transactionPoster == nil {
+		log.Println("Transactions channel not configured (DISCORD_TOKEN or DISCORD_TRANSACTIONS_CHANNEL_ID missing), skipping transactions")
+		return nil
+	}
+
+	league, err := a.interactor.GetLatestLeague(ctx)
+	if err != nil {
+		log.Printf("No league found, skipping transactions: %v", err)
+		return nil
+	}
+
+	if err := a.interactor.SyncTransactions(ctx, league.Year); err != nil {
+		return fmt.Errorf("failed to sync transactions: %w", err)
+	}
+
+	transactions, err := a.interactor.GetUnpostedTransactions(ctx, league.Year)
+	if err != nil {
+		return fmt.Errorf("failed to get unposted transactions: %w", err)
+	}
+
+	// Only the first run of a season skips older transactions
+	feedStarted, err := a.interactor.HasPostedTransactions(ctx, league.Year)
+	if err != nil {
+		return err
+	}
+
+	users, err := a.interactor.GetManagerNames(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+
+	posted, skipped := 0, 0
+	for _, t := range transactions {
+		if !feedStarted && time.Since(t.CreatedAt) > transactionPostWindow {
+			skipped++
+		} else {
+			if err := a.transactionPoster.PostTransaction(ctx, format.Transaction(t, users)); err != nil {
+				return fmt.Errorf("failed to post transaction %s: %w", t.ID, err)
+			}
+			posted++
+		}
+
+		if err := a.interactor.MarkTransactionPosted(ctx, t.ID); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("✅ Posted %d transactions (skipped %d older than %v)", posted, skipped, transactionPostWindow)
+	return nil
+}
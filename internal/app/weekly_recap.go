@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"time"
 
@@ -25,6 +24,9 @@ import (
 type WeeklyRecapApp struct {
 	weeklyJobInteractor interactor.WeeklyJobInteractor
 	channelPoster       *discord.ChannelPoster
+	transactionPoster   *discord.ChannelPoster
+	directMessenger     *discord.DirectMessenger
+	welcomeMessages     *discord.WelcomeMessages
 	interactor          interactor.Interactor
 	emailClient         *email.Client
 	queries             *db.Queries
@@ -42,6 +44,9 @@ func NewWeeklyRecapApp() (*WeeklyRecapApp, error) {
 	// Discord configuration (optional - if not set, Discord messages won't be sent)
 	discordToken := os.Getenv("DISCORD_TOKEN")
 	weeklyRecapChannelID := os.Getenv("DISCORD_WEEKLY_RECAP_CHANNEL_ID")
+	guildID := os.Getenv("DISCORD_GUILD_ID")
+	welcomeChannelID := os.Getenv("DISCORD_WELCOME_CHANNEL_ID")
+	transactionsChannelID := os.Getenv("DISCORD_TRANSACTIONS_CHANNEL_ID")
 
 	// Email configuration (optional - if not set, emails won't be sent)
 	resendAPIKey := os.Getenv("RESEND_API_KEY")
@@ -79,8 +84,8 @@ func NewWeeklyRecapApp() (*WeeklyRecapApp, error) {
 	// Initialize database queries
 	queries := db.New(pool)
 
-	// Initialize Sleeper client
-	sleeperClient := sleeper.NewSleeperClient(http.DefaultClient)
+	// Initialize Sleeper client, caching responses in the database
+	sleeperClient := sleeper.NewCachingClient(sleeper.NewSleeperClient(sleeper.NewHTTPClient()), sleeper.NewPostgresCacheStore(queries))
 
 	// Create dependency chain
 	chain := &dependency.Chain{
@@ -92,19 +97,35 @@ func NewWeeklyRecapApp() (*WeeklyRecapApp, error) {
 	// Initialize interactor
 	inter := interactor.NewInteractor(chain)
 
-	// Initialize Discord channel poster (optional)
+	// Initialize Discord channel poster and direct messenger (optional)
 	var channelPoster *discord.ChannelPoster
-	if discordToken != "" && weeklyRecapChannelID != "" {
+	var transactionPoster *discord.ChannelPoster
+	var directMessenger *discord.DirectMessenger
+	var welcomeMessages *discord.WelcomeMessages
+	if discordToken != "" {
 		session, err := discordgo.New("Bot " + discordToken)
 		if err != nil {
 			log.Printf("Warning: Failed to create Discord session: %v", err)
 			log.Println("Weekly recap will continue without Discord notifications")
 		} else {
-			channelPoster = discord.NewChannelPoster(session, weeklyRecapChannelID)
+			directMessenger = discord.NewDirectMessenger(session)
+			if guildID != "" {
+				welcomeMessages = discord.NewWelcomeMessages(dependency.NewDiscordWrapper(session), inter, guildID, welcomeChannelID)
+			} else {
+				log.Println("DISCORD_GUILD_ID missing, onboarding reminders will not be sent")
+			}
+			if weeklyRecapChannelID != "" {
+				channelPoster = discord.NewChannelPoster(session, weeklyRecapChannelID)
+			} else {
+				log.Println("DISCORD_WEEKLY_RECAP_CHANNEL_ID missing, weekly recap will not be posted to a channel")
+			}
+			if transactionsChannelID != "" {
+				transactionPoster = discord.NewChannelPoster(session, transactionsChannelID)
+			}
 			log.Println("✅ Discord client initialized successfully")
 		}
 	} else {
-		log.Println("Discord configuration not found (DISCORD_TOKEN or DISCORD_WEEKLY_RECAP_CHANNEL_ID missing)")
+		log.Println("Discord configuration not found (DISCORD_TOKEN missing)")
 		log.Println("Weekly recap will run without Discord notifications")
 	}
 
@@ -126,6 +147,9 @@ func NewWeeklyRecapApp() (*WeeklyRecapApp, error) {
 	return &WeeklyRecapApp{
 		weeklyJobInteractor: inter,
 		channelPoster:       channelPoster,
+		transactionPoster:   transactionPoster,
+		directMessenger:     directMessenger,
+		welcomeMessages:     welcomeMessages,
 		interactor:          inter,
 		emailClient:         emailClient,
 		queries:             queries,
@@ -158,6 +182,28 @@ func (a *WeeklyRecapApp) RunWeeklyRecap(ctx context.Context) error {
 	}
 	log.Println("✅ Data sync completed successfully")
 
+	// Mark the league COMPLETE once Sleeper reports the playoffs are over (optional, won't fail the job if it errors)
+	seasonCompleted, err := a.interactor.CompleteSeasonIfFinished(ctx, league.Year)
+	if err != nil {
+		log.Printf("⚠️  Failed to check whether the season is complete: %v", err)
+	} else if seasonCompleted {
+		log.Printf("✅ League year %d is now COMPLETE", league.Year)
+	}
+
+	// Record buy-ins and weekly high score payouts in the ledger (optional, won't fail the job if it errors)
+	if err := a.interactor.SettleSeasonLedger(ctx, league.Year); err != nil {
+		log.Printf("⚠️  Failed to update season ledger: %v", err)
+	} else {
+		log.Println("✅ Season ledger updated")
+	}
+
+	// Settle seasons played before the ledger existed, so career earnings include them (optional, like the above)
+	if years, err := a.interactor.SettleUnsettledSeasons(ctx); err != nil {
+		log.Printf("⚠️  Failed to settle past seasons' ledgers: %v", err)
+	} else if len(years) > 0 {
+		log.Printf("✅ Settled the ledger for past seasons %v", years)
+	}
+
 	// 2. Generate weekly summary message
 	message, err := a.GenerateWeeklySummaryMessage(ctx, league.Year)
 	if err != nil {
@@ -225,6 +271,17 @@ func (a *WeeklyRecapApp) RunWeeklyRecap(ctx context.Context) error {
 		log.Println("Email client not configured, skipping email notifications")
 	}
 
+	// 5. Hold the season awards ceremony and send year in review reports if the season just ended
+	// (optional, won't fail the job if they error)
+	if seasonCompleted {
+		if err := a.RunSeasonAwards(ctx, league.Year); err != nil {
+			log.Printf("⚠️  Failed to run season awards: %v", err)
+		}
+		if err := a.RunYearInReview(ctx, league.Year); err != nil {
+			log.Printf("⚠️  Failed to send year in review reports: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -239,7 +296,7 @@ func (a *WeeklyRecapApp) GenerateWeeklySummaryMessage(ctx context.Context, year
 	log.Printf("✅ Weekly summary generated for week %d", summary.Week)
 
 	// Get users for name formatting
-	users, err := a.interactor.GetUsers(ctx)
+	users, err := a.interactor.GetManagerNames(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get users: %w", err)
 	}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+// RunYearInReview sends every manager their personalized year-in-review by Discord DM and email.
+// Co-owners get the review of the franchise they manage.
+func (a *WeeklyRecapApp) RunYearInReview(ctx context.Context, year int) error {
+	log.Printf("Generating year in review reports for year %d", year)
+	reviews, err := a.interactor.GetYearInReviews(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to generate year in review reports: %w", err)
+	}
+	log.Printf("✅ Generated %d year in review reports", len(reviews))
+
+	// Get users for name formatting and Discord IDs
+	users, err := a.interactor.GetUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+
+	// Reviews are recorded under franchise owners, so look up who else manages each franchise
+	franchises, err := a.interactor.GetFranchises(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get franchises: %w", err)
+	}
+
+	// Send Discord DMs (optional, won't fail the job if they error)
+	if a.directMessenger != nil {
+		for _, review := range reviews {
+			for _, user := range franchises.ManagersOf(users[review.UserID]) {
+				if user.DiscordID == "" {
+					continue
+				}
+				if err := a.directMessenger.SendDirectMessage(ctx, user.DiscordID, review.ToDiscordMessage(users)); err != nil {
+					log.Printf("⚠️  Failed to DM year in review to %s: %v", user.Name, err)
+				} else {
+					log.Printf("✅ Sent year in review DM to %s", user.Name)
+				}
+			}
+		}
+	} else {
+		log.Println("Discord client not configured, skipping Discord DMs")
+	}
+
+	// Send emails (optional, won't fail the job if they error)
+	if a.emailClient != nil {
+		dbUsersWithEmail, err := a.queries.GetUsersWithEmail(ctx)
+		if err != nil {
+			log.Printf("⚠️  Failed to get users with email addresses: %v", err)
+			log.Println("Skipping email notifications")
+			return nil
+		}
+
+		reviewsByUser := make(map[string]domain.YearInReview, len(reviews))
+		for _, review := range reviews {
+			reviewsByUser[review.UserID] = review
+		}
+
+		sent := 0
+		for _, recipient := range converters.UsersFromDB(dbUsersWithEmail) {
+			review, ok := reviewsByUser[franchises.OwnerOf(recipient.ID)]
+			if !ok {
+				continue
+			}
+
+			// Rate limit: Resend allows 2 requests/second
+			if sent > 0 {
+				time.Sleep(600 * time.Millisecond)
+			}
+			sent++
+
+			if err := a.emailClient.SendYearInReview(ctx, review, recipient, users); err != nil {
+				log.Printf("⚠️  Failed to email year in review to %s (%s): %v", recipient.Name, recipient.Email, err)
+			} else {
+				log.Printf("✅ Sent year in review email to %s (%s)", recipient.Name, recipient.Email)
+			}
+		}
+	} else {
+		log.Println("Email client not configured, skipping email notifications")
+	}
+
+	return nil
+}
+
+// RunLatestYearInReview sends the year-in-review reports for the latest league, if it is complete
+func (a *WeeklyRecapApp) RunLatestYearInReview(ctx context.Context) error {
+	league, err := a.interactor.GetLatestLeague(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest league: %w", err)
+	}
+
+	if league.Status != domain.LeagueStatusComplete {
+		log.Printf("League year %d has status '%s' (not COMPLETE), skipping year in review", league.Year, league.Status)
+		return nil
+	}
+
+	return a.RunYearInReview(ctx, league.Year)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"time"
 
 	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
@@ -17,11 +16,38 @@ import (
 
 type Chain struct {
 	Pool          *pgxpool.Pool
-	DB            *db.Queries
-	SleeperClient *sleeper.SleeperClient
+	DB            IDatabase
+	SleeperClient sleeper.ISleeperClient
 	Discord       *discordgo.Session
 }
 
+// InTx runs fn in a database transaction, passing it a copy of the chain whose DB runs its queries in the
+// transaction. The transaction is committed if fn succeeds and rolled back otherwise. Chains without a pool,
+// such as mock chains and the chains InTx passes to fn, run fn on their DB as it is, so calls can be nested.
+func (c *Chain) InTx(ctx context.Context, fn func(*Chain) error) error {
+	if c.Pool == nil {
+		return fn(c)
+	}
+
+	tx, err := c.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Rolling back a committed transaction is a no-op
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(&Chain{DB: db.New(tx), SleeperClient: c.SleeperClient, Discord: c.Discord}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
 func NewDependencyChain(ctx context.Context, cfg *config.Config) (*Chain, error) {
 	pool, err := pgxpool.New(ctx, cfg.DBUrl)
 	if err != nil {
@@ -29,7 +55,8 @@ func NewDependencyChain(ctx context.Context, cfg *config.Config) (*Chain, error)
 	}
 	q := db.New(pool)
 
-	sleeperClient := sleeper.NewSleeperClient(http.DefaultClient)
+	// Sleeper responses are cached in the database so the bot keeps working while Sleeper is down
+	sleeperClient := sleeper.NewCachingClient(sleeper.NewSleeperClient(sleeper.NewHTTPClient()), sleeper.NewPostgresCacheStore(q))
 
 	dg, err := discordgo.New("Bot " + cfg.Token)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -15,6 +16,8 @@ type MockDatabase struct {
 	// Leagues
 	GetLatestLeagueFunc func(ctx context.Context) (db.League, error)
 	GetLeagueByYearFunc func(ctx context.Context, year int32) (db.League, error)
+	CompleteLeagueFunc  func(ctx context.Context, arg db.CompleteLeagueParams) error
+	GetLeagueYearsFunc  func(ctx context.Context) ([]int32, error)
 
 	// Users
 	GetUserByIDFunc func(ctx context.Context, id string) (db.User, error)
@@ -22,6 +25,7 @@ type MockDatabase struct {
 
 	// Matchups
 	GetLatestCompletedWeekFunc    func(ctx context.Context, year int32) (int32, error)
+	CorrectMatchupScoresFunc      func(ctx context.Context, arg db.CorrectMatchupScoresParams) error
 	GetMatchupByYearWeekUsersFunc func(ctx context.Context, arg db.GetMatchupByYearWeekUsersParams) (db.Matchup, error)
 	GetMatchupsByYearFunc         func(ctx context.Context, year int32) ([]db.Matchup, error)
 	GetWeeklyHighScoreFunc        func(ctx context.Context, arg db.GetWeeklyHighScoreParams) (db.GetWeeklyHighScoreRow, error)
@@ -30,6 +34,7 @@ type MockDatabase struct {
 
 	// Team stats
 	GetCareerStatsByDiscordIDFunc func(ctx context.Context, discordID string) (db.CareerStat, error)
+	GetCareerStatsByUserIDFunc    func(ctx context.Context, userID string) (db.CareerStat, error)
 
 	// Onboarding operations
 	GetUsersWithoutDiscordIDFunc func(ctx context.Context) ([]db.User, error)
@@ -37,6 +42,84 @@ type MockDatabase struct {
 	IsUserOnboardedFunc          func(ctx context.Context, discordID string) (bool, error)
 	GetUserByDiscordIDFunc       func(ctx context.Context, discordID string) (db.User, error)
 	CheckSleeperUserClaimedFunc  func(ctx context.Context, id string) (bool, error)
+	CompleteUserOnboardingFunc   func(ctx context.Context, discordID string) error
+
+	// Ledger operations
+	GetPayoutRulesByYearFunc          func(ctx context.Context, year int32) (db.SeasonPayoutRule, error)
+	UpsertPayoutRulesFunc             func(ctx context.Context, arg db.UpsertPayoutRulesParams) error
+	InsertLedgerEntryFunc             func(ctx context.Context, arg db.InsertLedgerEntryParams) error
+	DeleteSeasonPayoutsFunc           func(ctx context.Context, year int32) error
+	GetLedgerEntriesByUserAndYearFunc func(ctx context.Context, arg db.GetLedgerEntriesByUserAndYearParams) ([]db.LedgerEntry, error)
+	GetLedgerBalancesByYearFunc       func(ctx context.Context, year int32) ([]db.GetLedgerBalancesByYearRow, error)
+	GetCareerEarningsByUserIDFunc     func(ctx context.Context, userID string) (int32, error)
+	GetSettledLedgerYearsFunc         func(ctx context.Context) ([]int32, error)
+
+	// Dues operations
+	RecordDuesPaymentFunc   func(ctx context.Context, arg db.RecordDuesPaymentParams) error
+	DeleteDuesPaymentFunc   func(ctx context.Context, arg db.DeleteDuesPaymentParams) error
+	GetDuesStatusByYearFunc func(ctx context.Context, year int32) ([]db.GetDuesStatusByYearRow, error)
+
+	// Admin operations
+	InsertAdminAuditLogFunc func(ctx context.Context, arg db.InsertAdminAuditLogParams) error
+	GetAdminAuditLogFunc    func(ctx context.Context, limit int32) ([]db.AdminAuditLog, error)
+	UpdateUserEmailFunc     func(ctx context.Context, arg db.UpdateUserEmailParams) error
+	SetUserDiscordIDFunc    func(ctx context.Context, arg db.SetUserDiscordIDParams) error
+	ClearUserDiscordIDFunc  func(ctx context.Context, id string) error
+	UpdateLeagueStatusFunc  func(ctx context.Context, arg db.UpdateLeagueStatusParams) error
+
+	// Welcome message operations
+	UpsertWelcomeMessageFunc         func(ctx context.Context, arg db.UpsertWelcomeMessageParams) error
+	GetWelcomeMessagesFunc           func(ctx context.Context) ([]db.WelcomeMessage, error)
+	GetWelcomeMessageByDiscordIDFunc func(ctx context.Context, discordID string) (db.WelcomeMessage, error)
+	DeleteWelcomeMessageFunc         func(ctx context.Context, discordID string) error
+	RecordWelcomeReminderFunc        func(ctx context.Context, discordID string) error
+
+	// Franchise operations
+	UpsertFranchiseFunc         func(ctx context.Context, arg db.UpsertFranchiseParams) error
+	GetFranchisesFunc           func(ctx context.Context) ([]db.Franchise, error)
+	GetFranchiseByUserIDFunc    func(ctx context.Context, userID string) (db.Franchise, error)
+	GetFranchiseManagersFunc    func(ctx context.Context) ([]db.FranchiseManager, error)
+	DeleteFranchiseManagersFunc func(ctx context.Context, franchiseID int32) error
+	InsertFranchiseManagerFunc  func(ctx context.Context, arg db.InsertFranchiseManagerParams) error
+	InsertUserIfMissingFunc     func(ctx context.Context, arg db.InsertUserIfMissingParams) error
+
+	// Franchise history operations
+	InsertFranchiseIfMissingFunc    func(ctx context.Context, arg db.InsertFranchiseIfMissingParams) error
+	UpsertFranchiseOwnerFunc        func(ctx context.Context, arg db.UpsertFranchiseOwnerParams) error
+	GetFranchiseOwnersFunc          func(ctx context.Context, franchiseID int32) ([]db.FranchiseOwner, error)
+	GetFranchiseOwnersByYearFunc    func(ctx context.Context, year int32) ([]db.FranchiseOwner, error)
+	DeleteFranchiseCoOwnersFunc     func(ctx context.Context, arg db.DeleteFranchiseCoOwnersParams) error
+	InsertFranchiseCoOwnerFunc      func(ctx context.Context, arg db.InsertFranchiseCoOwnerParams) error
+	GetFranchiseSeasonsByUserIDFunc func(ctx context.Context, userID string) ([]db.FranchiseOwner, error)
+
+	// Transaction operations
+	GetPlayersByIDsFunc                func(ctx context.Context, ids []string) ([]db.Player, error)
+	UpsertPlayerFunc                   func(ctx context.Context, arg db.UpsertPlayerParams) error
+	InsertTransactionFunc              func(ctx context.Context, arg db.InsertTransactionParams) error
+	InsertTransactionPlayerFunc        func(ctx context.Context, arg db.InsertTransactionPlayerParams) error
+	InsertTransactionDraftPickFunc     func(ctx context.Context, arg db.InsertTransactionDraftPickParams) error
+	GetTransactionsByYearFunc          func(ctx context.Context, year int32) ([]db.Transaction, error)
+	GetUnpostedTransactionsByYearFunc  func(ctx context.Context, year int32) ([]db.Transaction, error)
+	HasPostedTransactionsFunc          func(ctx context.Context, year int32) (bool, error)
+	GetTransactionPlayersByYearFunc    func(ctx context.Context, year int32) ([]db.TransactionPlayer, error)
+	GetTransactionDraftPicksByYearFunc func(ctx context.Context, year int32) ([]db.TransactionDraftPick, error)
+	MarkTransactionPostedFunc          func(ctx context.Context, id string) error
+
+	// Player score operations
+	UpsertPlayerScoreFunc     func(ctx context.Context, arg db.UpsertPlayerScoreParams) error
+	GetPlayerScoresByYearFunc func(ctx context.Context, year int32) ([]db.PlayerScore, error)
+
+	// Draft operations
+	UpsertDraftPickFunc     func(ctx context.Context, arg db.UpsertDraftPickParams) error
+	GetDraftPicksByYearFunc func(ctx context.Context, year int32) ([]db.DraftPick, error)
+
+	// Traded draft pick operations
+	UpsertTradedDraftPickFunc         func(ctx context.Context, arg db.UpsertTradedDraftPickParams) error
+	GetTradedDraftPicksFromSeasonFunc func(ctx context.Context, season int32) ([]db.TradedDraftPick, error)
+
+	// Sleeper response cache operations
+	GetSleeperCacheEntryFunc    func(ctx context.Context, key string) (db.SleeperCache, error)
+	UpsertSleeperCacheEntryFunc func(ctx context.Context, arg db.UpsertSleeperCacheEntryParams) error
 }
 
 func (m *MockDatabase) GetLatestLeague(ctx context.Context) (db.League, error) {
@@ -53,6 +136,20 @@ func (m *MockDatabase) GetLeagueByYear(ctx context.Context, year int32) (db.Leag
 	return db.League{}, nil
 }
 
+func (m *MockDatabase) CompleteLeague(ctx context.Context, arg db.CompleteLeagueParams) error {
+	if m.CompleteLeagueFunc != nil {
+		return m.CompleteLeagueFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetLeagueYears(ctx context.Context) ([]int32, error) {
+	if m.GetLeagueYearsFunc != nil {
+		return m.GetLeagueYearsFunc(ctx)
+	}
+	return nil, nil
+}
+
 func (m *MockDatabase) GetUserByID(ctx context.Context, id string) (db.User, error) {
 	if m.GetUserByIDFunc != nil {
 		return m.GetUserByIDFunc(ctx, id)
@@ -102,6 +199,13 @@ func (m *MockDatabase) InsertMatchup(ctx context.Context, arg db.InsertMatchupPa
 	return pgtype.UUID{}, nil
 }
 
+func (m *MockDatabase) CorrectMatchupScores(ctx context.Context, arg db.CorrectMatchupScoresParams) error {
+	if m.CorrectMatchupScoresFunc != nil {
+		return m.CorrectMatchupScoresFunc(ctx, arg)
+	}
+	return nil
+}
+
 func (m *MockDatabase) UpdateMatchupScores(ctx context.Context, arg db.UpdateMatchupScoresParams) error {
 	if m.UpdateMatchupScoresFunc != nil {
 		return m.UpdateMatchupScoresFunc(ctx, arg)
@@ -116,6 +220,13 @@ func (m *MockDatabase) GetCareerStatsByDiscordID(ctx context.Context, discordID
 	return db.CareerStat{}, nil
 }
 
+func (m *MockDatabase) GetCareerStatsByUserID(ctx context.Context, userID string) (db.CareerStat, error) {
+	if m.GetCareerStatsByUserIDFunc != nil {
+		return m.GetCareerStatsByUserIDFunc(ctx, userID)
+	}
+	return db.CareerStat{}, nil
+}
+
 func (m *MockDatabase) GetUsersWithoutDiscordID(ctx context.Context) ([]db.User, error) {
 	if m.GetUsersWithoutDiscordIDFunc != nil {
 		return m.GetUsersWithoutDiscordIDFunc(ctx)
@@ -151,6 +262,398 @@ func (m *MockDatabase) CheckSleeperUserClaimed(ctx context.Context, id string) (
 	return false, nil
 }
 
+func (m *MockDatabase) CompleteUserOnboarding(ctx context.Context, discordID string) error {
+	if m.CompleteUserOnboardingFunc != nil {
+		return m.CompleteUserOnboardingFunc(ctx, discordID)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetPayoutRulesByYear(ctx context.Context, year int32) (db.SeasonPayoutRule, error) {
+	if m.GetPayoutRulesByYearFunc != nil {
+		return m.GetPayoutRulesByYearFunc(ctx, year)
+	}
+	return db.SeasonPayoutRule{}, nil
+}
+
+func (m *MockDatabase) UpsertPayoutRules(ctx context.Context, arg db.UpsertPayoutRulesParams) error {
+	if m.UpsertPayoutRulesFunc != nil {
+		return m.UpsertPayoutRulesFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) DeleteSeasonPayouts(ctx context.Context, year int32) error {
+	if m.DeleteSeasonPayoutsFunc != nil {
+		return m.DeleteSeasonPayoutsFunc(ctx, year)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertLedgerEntry(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+	if m.InsertLedgerEntryFunc != nil {
+		return m.InsertLedgerEntryFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetLedgerEntriesByUserAndYear(ctx context.Context, arg db.GetLedgerEntriesByUserAndYearParams) ([]db.LedgerEntry, error) {
+	if m.GetLedgerEntriesByUserAndYearFunc != nil {
+		return m.GetLedgerEntriesByUserAndYearFunc(ctx, arg)
+	}
+	return []db.LedgerEntry{}, nil
+}
+
+func (m *MockDatabase) GetLedgerBalancesByYear(ctx context.Context, year int32) ([]db.GetLedgerBalancesByYearRow, error) {
+	if m.GetLedgerBalancesByYearFunc != nil {
+		return m.GetLedgerBalancesByYearFunc(ctx, year)
+	}
+	return []db.GetLedgerBalancesByYearRow{}, nil
+}
+
+func (m *MockDatabase) GetSettledLedgerYears(ctx context.Context) ([]int32, error) {
+	if m.GetSettledLedgerYearsFunc != nil {
+		return m.GetSettledLedgerYearsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) GetCareerEarningsByUserID(ctx context.Context, userID string) (int32, error) {
+	if m.GetCareerEarningsByUserIDFunc != nil {
+		return m.GetCareerEarningsByUserIDFunc(ctx, userID)
+	}
+	return 0, nil
+}
+
+func (m *MockDatabase) RecordDuesPayment(ctx context.Context, arg db.RecordDuesPaymentParams) error {
+	if m.RecordDuesPaymentFunc != nil {
+		return m.RecordDuesPaymentFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) DeleteDuesPayment(ctx context.Context, arg db.DeleteDuesPaymentParams) error {
+	if m.DeleteDuesPaymentFunc != nil {
+		return m.DeleteDuesPaymentFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetDuesStatusByYear(ctx context.Context, year int32) ([]db.GetDuesStatusByYearRow, error) {
+	if m.GetDuesStatusByYearFunc != nil {
+		return m.GetDuesStatusByYearFunc(ctx, year)
+	}
+	return []db.GetDuesStatusByYearRow{}, nil
+}
+
+func (m *MockDatabase) InsertAdminAuditLog(ctx context.Context, arg db.InsertAdminAuditLogParams) error {
+	if m.InsertAdminAuditLogFunc != nil {
+		return m.InsertAdminAuditLogFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetAdminAuditLog(ctx context.Context, limit int32) ([]db.AdminAuditLog, error) {
+	if m.GetAdminAuditLogFunc != nil {
+		return m.GetAdminAuditLogFunc(ctx, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) UpdateUserEmail(ctx context.Context, arg db.UpdateUserEmailParams) error {
+	if m.UpdateUserEmailFunc != nil {
+		return m.UpdateUserEmailFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) SetUserDiscordID(ctx context.Context, arg db.SetUserDiscordIDParams) error {
+	if m.SetUserDiscordIDFunc != nil {
+		return m.SetUserDiscordIDFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) ClearUserDiscordID(ctx context.Context, id string) error {
+	if m.ClearUserDiscordIDFunc != nil {
+		return m.ClearUserDiscordIDFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockDatabase) UpdateLeagueStatus(ctx context.Context, arg db.UpdateLeagueStatusParams) error {
+	if m.UpdateLeagueStatusFunc != nil {
+		return m.UpdateLeagueStatusFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) UpsertWelcomeMessage(ctx context.Context, arg db.UpsertWelcomeMessageParams) error {
+	if m.UpsertWelcomeMessageFunc != nil {
+		return m.UpsertWelcomeMessageFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetWelcomeMessages(ctx context.Context) ([]db.WelcomeMessage, error) {
+	if m.GetWelcomeMessagesFunc != nil {
+		return m.GetWelcomeMessagesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) GetWelcomeMessageByDiscordID(ctx context.Context, discordID string) (db.WelcomeMessage, error) {
+	if m.GetWelcomeMessageByDiscordIDFunc != nil {
+		return m.GetWelcomeMessageByDiscordIDFunc(ctx, discordID)
+	}
+	return db.WelcomeMessage{}, nil
+}
+
+func (m *MockDatabase) DeleteWelcomeMessage(ctx context.Context, discordID string) error {
+	if m.DeleteWelcomeMessageFunc != nil {
+		return m.DeleteWelcomeMessageFunc(ctx, discordID)
+	}
+	return nil
+}
+
+func (m *MockDatabase) RecordWelcomeReminder(ctx context.Context, discordID string) error {
+	if m.RecordWelcomeReminderFunc != nil {
+		return m.RecordWelcomeReminderFunc(ctx, discordID)
+	}
+	return nil
+}
+
+func (m *MockDatabase) UpsertFranchise(ctx context.Context, arg db.UpsertFranchiseParams) error {
+	if m.UpsertFranchiseFunc != nil {
+		return m.UpsertFranchiseFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetFranchises(ctx context.Context) ([]db.Franchise, error) {
+	if m.GetFranchisesFunc != nil {
+		return m.GetFranchisesFunc(ctx)
+	}
+	return []db.Franchise{}, nil
+}
+
+func (m *MockDatabase) GetFranchiseByUserID(ctx context.Context, userID string) (db.Franchise, error) {
+	if m.GetFranchiseByUserIDFunc != nil {
+		return m.GetFranchiseByUserIDFunc(ctx, userID)
+	}
+	return db.Franchise{}, pgx.ErrNoRows
+}
+
+func (m *MockDatabase) GetFranchiseManagers(ctx context.Context) ([]db.FranchiseManager, error) {
+	if m.GetFranchiseManagersFunc != nil {
+		return m.GetFranchiseManagersFunc(ctx)
+	}
+	return []db.FranchiseManager{}, nil
+}
+
+func (m *MockDatabase) DeleteFranchiseManagers(ctx context.Context, franchiseID int32) error {
+	if m.DeleteFranchiseManagersFunc != nil {
+		return m.DeleteFranchiseManagersFunc(ctx, franchiseID)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertFranchiseManager(ctx context.Context, arg db.InsertFranchiseManagerParams) error {
+	if m.InsertFranchiseManagerFunc != nil {
+		return m.InsertFranchiseManagerFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertUserIfMissing(ctx context.Context, arg db.InsertUserIfMissingParams) error {
+	if m.InsertUserIfMissingFunc != nil {
+		return m.InsertUserIfMissingFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertFranchiseIfMissing(ctx context.Context, arg db.InsertFranchiseIfMissingParams) error {
+	if m.InsertFranchiseIfMissingFunc != nil {
+		return m.InsertFranchiseIfMissingFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) UpsertFranchiseOwner(ctx context.Context, arg db.UpsertFranchiseOwnerParams) error {
+	if m.UpsertFranchiseOwnerFunc != nil {
+		return m.UpsertFranchiseOwnerFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetFranchiseOwners(ctx context.Context, franchiseID int32) ([]db.FranchiseOwner, error) {
+	if m.GetFranchiseOwnersFunc != nil {
+		return m.GetFranchiseOwnersFunc(ctx, franchiseID)
+	}
+	return []db.FranchiseOwner{}, nil
+}
+
+func (m *MockDatabase) GetFranchiseOwnersByYear(ctx context.Context, year int32) ([]db.FranchiseOwner, error) {
+	if m.GetFranchiseOwnersByYearFunc != nil {
+		return m.GetFranchiseOwnersByYearFunc(ctx, year)
+	}
+	return []db.FranchiseOwner{}, nil
+}
+
+func (m *MockDatabase) DeleteFranchiseCoOwners(ctx context.Context, arg db.DeleteFranchiseCoOwnersParams) error {
+	if m.DeleteFranchiseCoOwnersFunc != nil {
+		return m.DeleteFranchiseCoOwnersFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertFranchiseCoOwner(ctx context.Context, arg db.InsertFranchiseCoOwnerParams) error {
+	if m.InsertFranchiseCoOwnerFunc != nil {
+		return m.InsertFranchiseCoOwnerFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetFranchiseSeasonsByUserID(ctx context.Context, userID string) ([]db.FranchiseOwner, error) {
+	if m.GetFranchiseSeasonsByUserIDFunc != nil {
+		return m.GetFranchiseSeasonsByUserIDFunc(ctx, userID)
+	}
+	return []db.FranchiseOwner{}, nil
+}
+
+func (m *MockDatabase) GetPlayersByIDs(ctx context.Context, ids []string) ([]db.Player, error) {
+	if m.GetPlayersByIDsFunc != nil {
+		return m.GetPlayersByIDsFunc(ctx, ids)
+	}
+	return []db.Player{}, nil
+}
+
+func (m *MockDatabase) UpsertPlayer(ctx context.Context, arg db.UpsertPlayerParams) error {
+	if m.UpsertPlayerFunc != nil {
+		return m.UpsertPlayerFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertTransaction(ctx context.Context, arg db.InsertTransactionParams) error {
+	if m.InsertTransactionFunc != nil {
+		return m.InsertTransactionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertTransactionPlayer(ctx context.Context, arg db.InsertTransactionPlayerParams) error {
+	if m.InsertTransactionPlayerFunc != nil {
+		return m.InsertTransactionPlayerFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) InsertTransactionDraftPick(ctx context.Context, arg db.InsertTransactionDraftPickParams) error {
+	if m.InsertTransactionDraftPickFunc != nil {
+		return m.InsertTransactionDraftPickFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetTransactionsByYear(ctx context.Context, year int32) ([]db.Transaction, error) {
+	if m.GetTransactionsByYearFunc != nil {
+		return m.GetTransactionsByYearFunc(ctx, year)
+	}
+	return []db.Transaction{}, nil
+}
+
+func (m *MockDatabase) GetUnpostedTransactionsByYear(ctx context.Context, year int32) ([]db.Transaction, error) {
+	if m.GetUnpostedTransactionsByYearFunc != nil {
+		return m.GetUnpostedTransactionsByYearFunc(ctx, year)
+	}
+	return []db.Transaction{}, nil
+}
+
+func (m *MockDatabase) HasPostedTransactions(ctx context.Context, year int32) (bool, error) {
+	if m.HasPostedTransactionsFunc != nil {
+		return m.HasPostedTransactionsFunc(ctx, year)
+	}
+	return false, nil
+}
+
+func (m *MockDatabase) GetTransactionPlayersByYear(ctx context.Context, year int32) ([]db.TransactionPlayer, error) {
+	if m.GetTransactionPlayersByYearFunc != nil {
+		return m.GetTransactionPlayersByYearFunc(ctx, year)
+	}
+	return []db.TransactionPlayer{}, nil
+}
+
+func (m *MockDatabase) GetTransactionDraftPicksByYear(ctx context.Context, year int32) ([]db.TransactionDraftPick, error) {
+	if m.GetTransactionDraftPicksByYearFunc != nil {
+		return m.GetTransactionDraftPicksByYearFunc(ctx, year)
+	}
+	return []db.TransactionDraftPick{}, nil
+}
+
+func (m *MockDatabase) MarkTransactionPosted(ctx context.Context, id string) error {
+	if m.MarkTransactionPostedFunc != nil {
+		return m.MarkTransactionPostedFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockDatabase) UpsertPlayerScore(ctx context.Context, arg db.UpsertPlayerScoreParams) error {
+	if m.UpsertPlayerScoreFunc != nil {
+		return m.UpsertPlayerScoreFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetPlayerScoresByYear(ctx context.Context, year int32) ([]db.PlayerScore, error) {
+	if m.GetPlayerScoresByYearFunc != nil {
+		return m.GetPlayerScoresByYearFunc(ctx, year)
+	}
+	return []db.PlayerScore{}, nil
+}
+
+func (m *MockDatabase) UpsertDraftPick(ctx context.Context, arg db.UpsertDraftPickParams) error {
+	if m.UpsertDraftPickFunc != nil {
+		return m.UpsertDraftPickFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetDraftPicksByYear(ctx context.Context, year int32) ([]db.DraftPick, error) {
+	if m.GetDraftPicksByYearFunc != nil {
+		return m.GetDraftPicksByYearFunc(ctx, year)
+	}
+	return []db.DraftPick{}, nil
+}
+
+func (m *MockDatabase) UpsertTradedDraftPick(ctx context.Context, arg db.UpsertTradedDraftPickParams) error {
+	if m.UpsertTradedDraftPickFunc != nil {
+		return m.UpsertTradedDraftPickFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockDatabase) GetTradedDraftPicksFromSeason(ctx context.Context, season int32) ([]db.TradedDraftPick, error) {
+	if m.GetTradedDraftPicksFromSeasonFunc != nil {
+		return m.GetTradedDraftPicksFromSeasonFunc(ctx, season)
+	}
+	return []db.TradedDraftPick{}, nil
+}
+
+func (m *MockDatabase) GetSleeperCacheEntry(ctx context.Context, key string) (db.SleeperCache, error) {
+	if m.GetSleeperCacheEntryFunc != nil {
+		return m.GetSleeperCacheEntryFunc(ctx, key)
+	}
+	return db.SleeperCache{}, pgx.ErrNoRows
+}
+
+func (m *MockDatabase) UpsertSleeperCacheEntry(ctx context.Context, arg db.UpsertSleeperCacheEntryParams) error {
+	if m.UpsertSleeperCacheEntryFunc != nil {
+		return m.UpsertSleeperCacheEntryFunc(ctx, arg)
+	}
+	return nil
+}
+
 // MockSleeperClient provides a mock implementation for testing
 type MockSleeperClient struct {
 	GetUserFunc            func(ctx context.Context, userID string) (sleeper.SleeperUser, error)
@@ -158,6 +661,11 @@ type MockSleeperClient struct {
 	GetUsersInLeagueFunc   func(ctx context.Context, leagueID string) (sleeper.SleeperUsers, error)
 	GetRostersInLeagueFunc func(ctx context.Context, leagueID string) (sleeper.Rosters, error)
 	GetMatchupsForWeekFunc func(ctx context.Context, leagueID string, week int) (sleeper.Matchups, error)
+	GetWinnersBracketFunc  func(ctx context.Context, leagueID string) (sleeper.Bracket, error)
+	GetTransactionsFunc    func(ctx context.Context, leagueID string, week int) (sleeper.Transactions, error)
+	GetTradedPicksFunc     func(ctx context.Context, leagueID string) (sleeper.TradedPicks, error)
+	GetDraftFunc           func(ctx context.Context, draftID string) (sleeper.Draft, error)
+	GetDraftPicksFunc      func(ctx context.Context, draftID string) (sleeper.DraftPicks, error)
 	GetNFLStateFunc        func(ctx context.Context) (sleeper.NFLState, error)
 	FetchAllPlayersFunc    func(ctx context.Context) ([]byte, error)
 }
@@ -197,6 +705,41 @@ func (m *MockSleeperClient) GetMatchupsForWeek(ctx context.Context, leagueID str
 	return sleeper.Matchups{}, nil
 }
 
+func (m *MockSleeperClient) GetWinnersBracket(ctx context.Context, leagueID string) (sleeper.Bracket, error) {
+	if m.GetWinnersBracketFunc != nil {
+		return m.GetWinnersBracketFunc(ctx, leagueID)
+	}
+	return sleeper.Bracket{}, nil
+}
+
+func (m *MockSleeperClient) GetTransactions(ctx context.Context, leagueID string, week int) (sleeper.Transactions, error) {
+	if m.GetTransactionsFunc != nil {
+		return m.GetTransactionsFunc(ctx, leagueID, week)
+	}
+	return sleeper.Transactions{}, nil
+}
+
+func (m *MockSleeperClient) GetTradedPicks(ctx context.Context, leagueID string) (sleeper.TradedPicks, error) {
+	if m.GetTradedPicksFunc != nil {
+		return m.GetTradedPicksFunc(ctx, leagueID)
+	}
+	return sleeper.TradedPicks{}, nil
+}
+
+func (m *MockSleeperClient) GetDraft(ctx context.Context, draftID string) (sleeper.Draft, error) {
+	if m.GetDraftFunc != nil {
+		return m.GetDraftFunc(ctx, draftID)
+	}
+	return sleeper.Draft{}, nil
+}
+
+func (m *MockSleeperClient) GetDraftPicks(ctx context.Context, draftID string) (sleeper.DraftPicks, error) {
+	if m.GetDraftPicksFunc != nil {
+		return m.GetDraftPicksFunc(ctx, draftID)
+	}
+	return sleeper.DraftPicks{}, nil
+}
+
 func (m *MockSleeperClient) GetNFLState(ctx context.Context) (sleeper.NFLState, error) {
 	if m.GetNFLStateFunc != nil {
 		return m.GetNFLStateFunc(ctx)
@@ -213,14 +756,24 @@ func (m *MockSleeperClient) FetchAllPlayers(ctx context.Context) ([]byte, error)
 
 // MockDiscordSession provides a mock implementation of IDiscordSession for testing
 type MockDiscordSession struct {
-	InteractionRespondFunc        func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error
-	GuildMemberFunc               func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error)
-	ApplicationCommandCreateFunc  func(appID string, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error)
-	AddHandlerFunc                func(handler interface{}) func()
-	OpenFunc                      func() error
-	CloseFunc                     func() error
-	ChannelMessageSendComplexFunc func(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error)
-	ChannelMessageSendFunc        func(channelID, content string) (*discordgo.Message, error)
+	InteractionRespondFunc              func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error
+	GuildMemberFunc                     func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error)
+	ApplicationCommandCreateFunc        func(appID string, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error)
+	ApplicationCommandBulkOverwriteFunc func(appID string, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error)
+	AddHandlerFunc                      func(handler interface{}) func()
+	OpenFunc                            func() error
+	CloseFunc                           func() error
+	ChannelMessageSendComplexFunc       func(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error)
+	ChannelMessageSendFunc              func(channelID, content string) (*discordgo.Message, error)
+	GuildMemberRoleAddFunc              func(guildID, userID, roleID string, options ...discordgo.RequestOption) error
+	GuildMemberNicknameFunc             func(guildID, userID, nickname string, options ...discordgo.RequestOption) error
+	ChannelMessageEditComplexFunc       func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	ChannelMessageDeleteFunc            func(channelID, messageID string, options ...discordgo.RequestOption) error
+	UserChannelCreateFunc               func(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+	ThreadStartComplexFunc              func(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+	ThreadMemberAddFunc                 func(threadID, memberID string, options ...discordgo.RequestOption) error
+	GuildMembersFunc                    func(guildID string, after string, limit int, options ...discordgo.RequestOption) ([]*discordgo.Member, error)
+	ChannelMessagesFunc                 func(channelID string, limit int, beforeID, afterID, aroundID string, options ...discordgo.RequestOption) ([]*discordgo.Message, error)
 
 	// Call tracking for tests
 	InteractionRespondCalled        bool
@@ -250,6 +803,13 @@ func (m *MockDiscordSession) ApplicationCommandCreate(appID string, guildID stri
 	return &discordgo.ApplicationCommand{}, nil
 }
 
+func (m *MockDiscordSession) ApplicationCommandBulkOverwrite(appID string, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+	if m.ApplicationCommandBulkOverwriteFunc != nil {
+		return m.ApplicationCommandBulkOverwriteFunc(appID, guildID, commands, options...)
+	}
+	return commands, nil
+}
+
 func (m *MockDiscordSession) AddHandler(handler interface{}) func() {
 	if m.AddHandlerFunc != nil {
 		return m.AddHandlerFunc(handler)
@@ -287,11 +847,73 @@ func (m *MockDiscordSession) ChannelMessageSend(channelID, content string) (*dis
 	return &discordgo.Message{}, nil
 }
 
-// NewMockChain creates a test dependency chain with default mock implementations
-func NewMockChain() *TestChain {
-	return &TestChain{
+func (m *MockDiscordSession) GuildMemberRoleAdd(guildID, userID, roleID string, options ...discordgo.RequestOption) error {
+	if m.GuildMemberRoleAddFunc != nil {
+		return m.GuildMemberRoleAddFunc(guildID, userID, roleID, options...)
+	}
+	return nil
+}
+
+func (m *MockDiscordSession) GuildMemberNickname(guildID, userID, nickname string, options ...discordgo.RequestOption) error {
+	if m.GuildMemberNicknameFunc != nil {
+		return m.GuildMemberNicknameFunc(guildID, userID, nickname, options...)
+	}
+	return nil
+}
+
+func (m *MockDiscordSession) ChannelMessageEditComplex(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	if m.ChannelMessageEditComplexFunc != nil {
+		return m.ChannelMessageEditComplexFunc(edit, options...)
+	}
+	return &discordgo.Message{}, nil
+}
+
+func (m *MockDiscordSession) ChannelMessageDelete(channelID, messageID string, options ...discordgo.RequestOption) error {
+	if m.ChannelMessageDeleteFunc != nil {
+		return m.ChannelMessageDeleteFunc(channelID, messageID, options...)
+	}
+	return nil
+}
+
+func (m *MockDiscordSession) UserChannelCreate(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	if m.UserChannelCreateFunc != nil {
+		return m.UserChannelCreateFunc(recipientID, options...)
+	}
+	return &discordgo.Channel{ID: "dm-" + recipientID}, nil
+}
+
+func (m *MockDiscordSession) ThreadStartComplex(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	if m.ThreadStartComplexFunc != nil {
+		return m.ThreadStartComplexFunc(channelID, data, options...)
+	}
+	return &discordgo.Channel{ID: "thread-" + channelID, ParentID: channelID, Type: data.Type}, nil
+}
+
+func (m *MockDiscordSession) ThreadMemberAdd(threadID, memberID string, options ...discordgo.RequestOption) error {
+	if m.ThreadMemberAddFunc != nil {
+		return m.ThreadMemberAddFunc(threadID, memberID, options...)
+	}
+	return nil
+}
+
+func (m *MockDiscordSession) GuildMembers(guildID string, after string, limit int, options ...discordgo.RequestOption) ([]*discordgo.Member, error) {
+	if m.GuildMembersFunc != nil {
+		return m.GuildMembersFunc(guildID, after, limit, options...)
+	}
+	return nil, nil
+}
+
+func (m *MockDiscordSession) ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string, options ...discordgo.RequestOption) ([]*discordgo.Message, error) {
+	if m.ChannelMessagesFunc != nil {
+		return m.ChannelMessagesFunc(channelID, limit, beforeID, afterID, aroundID, options...)
+	}
+	return nil, nil
+}
+
+// NewMockChain creates a dependency chain with default mock implementations
+func NewMockChain() *Chain {
+	return &Chain{
 		DB:            &MockDatabase{},
 		SleeperClient: &MockSleeperClient{},
-		Discord:       &MockDiscordSession{},
 	}
 }
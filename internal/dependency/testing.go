@@ -3,18 +3,19 @@ package dependency
 import (
 	"context"
 
-	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-// IDatabase wraps the SQLC generated Queries for testing
+// IDatabase is the database queries the dependency chain provides, so tests can substitute MockDatabase
 type IDatabase interface {
 	// League operations
 	GetLatestLeague(ctx context.Context) (db.League, error)
 	GetLeagueByYear(ctx context.Context, year int32) (db.League, error)
+	CompleteLeague(ctx context.Context, arg db.CompleteLeagueParams) error
+	GetLeagueYears(ctx context.Context) ([]int32, error)
 
 	// User operations
 	GetUserByID(ctx context.Context, id string) (db.User, error)
@@ -22,6 +23,7 @@ type IDatabase interface {
 
 	// Matchup operations
 	GetLatestCompletedWeek(ctx context.Context, year int32) (int32, error)
+	CorrectMatchupScores(ctx context.Context, arg db.CorrectMatchupScoresParams) error
 	GetMatchupByYearWeekUsers(ctx context.Context, arg db.GetMatchupByYearWeekUsersParams) (db.Matchup, error)
 	GetMatchupsByYear(ctx context.Context, year int32) ([]db.Matchup, error)
 	GetWeeklyHighScore(ctx context.Context, arg db.GetWeeklyHighScoreParams) (db.GetWeeklyHighScoreRow, error)
@@ -30,6 +32,7 @@ type IDatabase interface {
 
 	// Team stats operations
 	GetCareerStatsByDiscordID(ctx context.Context, discordID string) (db.CareerStat, error)
+	GetCareerStatsByUserID(ctx context.Context, userID string) (db.CareerStat, error)
 
 	// Onboarding operations
 	GetUsersWithoutDiscordID(ctx context.Context) ([]db.User, error)
@@ -37,11 +40,84 @@ type IDatabase interface {
 	IsUserOnboarded(ctx context.Context, discordID string) (bool, error)
 	GetUserByDiscordID(ctx context.Context, discordID string) (db.User, error)
 	CheckSleeperUserClaimed(ctx context.Context, id string) (bool, error)
-}
-
-// ISleeperClient aliases the sleeper client interface for testing
-type ISleeperClient interface {
-	sleeper.ISleeperClient
+	CompleteUserOnboarding(ctx context.Context, discordID string) error
+
+	// Ledger operations
+	GetPayoutRulesByYear(ctx context.Context, year int32) (db.SeasonPayoutRule, error)
+	UpsertPayoutRules(ctx context.Context, arg db.UpsertPayoutRulesParams) error
+	InsertLedgerEntry(ctx context.Context, arg db.InsertLedgerEntryParams) error
+	DeleteSeasonPayouts(ctx context.Context, year int32) error
+	GetLedgerEntriesByUserAndYear(ctx context.Context, arg db.GetLedgerEntriesByUserAndYearParams) ([]db.LedgerEntry, error)
+	GetLedgerBalancesByYear(ctx context.Context, year int32) ([]db.GetLedgerBalancesByYearRow, error)
+	GetCareerEarningsByUserID(ctx context.Context, userID string) (int32, error)
+	GetSettledLedgerYears(ctx context.Context) ([]int32, error)
+
+	// Dues operations
+	RecordDuesPayment(ctx context.Context, arg db.RecordDuesPaymentParams) error
+	DeleteDuesPayment(ctx context.Context, arg db.DeleteDuesPaymentParams) error
+	GetDuesStatusByYear(ctx context.Context, year int32) ([]db.GetDuesStatusByYearRow, error)
+
+	// Admin operations
+	InsertAdminAuditLog(ctx context.Context, arg db.InsertAdminAuditLogParams) error
+	GetAdminAuditLog(ctx context.Context, limit int32) ([]db.AdminAuditLog, error)
+	UpdateUserEmail(ctx context.Context, arg db.UpdateUserEmailParams) error
+	SetUserDiscordID(ctx context.Context, arg db.SetUserDiscordIDParams) error
+	ClearUserDiscordID(ctx context.Context, id string) error
+	UpdateLeagueStatus(ctx context.Context, arg db.UpdateLeagueStatusParams) error
+
+	// Welcome message operations
+	UpsertWelcomeMessage(ctx context.Context, arg db.UpsertWelcomeMessageParams) error
+	GetWelcomeMessages(ctx context.Context) ([]db.WelcomeMessage, error)
+	GetWelcomeMessageByDiscordID(ctx context.Context, discordID string) (db.WelcomeMessage, error)
+	DeleteWelcomeMessage(ctx context.Context, discordID string) error
+	RecordWelcomeReminder(ctx context.Context, discordID string) error
+
+	// Franchise operations
+	UpsertFranchise(ctx context.Context, arg db.UpsertFranchiseParams) error
+	GetFranchises(ctx context.Context) ([]db.Franchise, error)
+	GetFranchiseByUserID(ctx context.Context, userID string) (db.Franchise, error)
+	GetFranchiseManagers(ctx context.Context) ([]db.FranchiseManager, error)
+	DeleteFranchiseManagers(ctx context.Context, franchiseID int32) error
+	InsertFranchiseManager(ctx context.Context, arg db.InsertFranchiseManagerParams) error
+	InsertUserIfMissing(ctx context.Context, arg db.InsertUserIfMissingParams) error
+
+	// Franchise history operations
+	InsertFranchiseIfMissing(ctx context.Context, arg db.InsertFranchiseIfMissingParams) error
+	UpsertFranchiseOwner(ctx context.Context, arg db.UpsertFranchiseOwnerParams) error
+	GetFranchiseOwners(ctx context.Context, franchiseID int32) ([]db.FranchiseOwner, error)
+	GetFranchiseOwnersByYear(ctx context.Context, year int32) ([]db.FranchiseOwner, error)
+	DeleteFranchiseCoOwners(ctx context.Context, arg db.DeleteFranchiseCoOwnersParams) error
+	InsertFranchiseCoOwner(ctx context.Context, arg db.InsertFranchiseCoOwnerParams) error
+	GetFranchiseSeasonsByUserID(ctx context.Context, userID string) ([]db.FranchiseOwner, error)
+
+	// Transaction operations
+	GetPlayersByIDs(ctx context.Context, ids []string) ([]db.Player, error)
+	UpsertPlayer(ctx context.Context, arg db.UpsertPlayerParams) error
+	InsertTransaction(ctx context.Context, arg db.InsertTransactionParams) error
+	InsertTransactionPlayer(ctx context.Context, arg db.InsertTransactionPlayerParams) error
+	InsertTransactionDraftPick(ctx context.Context, arg db.InsertTransactionDraftPickParams) error
+	GetTransactionsByYear(ctx context.Context, year int32) ([]db.Transaction, error)
+	GetUnpostedTransactionsByYear(ctx context.Context, year int32) ([]db.Transaction, error)
+	HasPostedTransactions(ctx context.Context, year int32) (bool, error)
+	GetTransactionPlayersByYear(ctx context.Context, year int32) ([]db.TransactionPlayer, error)
+	GetTransactionDraftPicksByYear(ctx context.Context, year int32) ([]db.TransactionDraftPick, error)
+	MarkTransactionPosted(ctx context.Context, id string) error
+
+	// Player score operations
+	UpsertPlayerScore(ctx context.Context, arg db.UpsertPlayerScoreParams) error
+	GetPlayerScoresByYear(ctx context.Context, year int32) ([]db.PlayerScore, error)
+
+	// Draft operations
+	UpsertDraftPick(ctx context.Context, arg db.UpsertDraftPickParams) error
+	GetDraftPicksByYear(ctx context.Context, year int32) ([]db.DraftPick, error)
+
+	// Traded draft pick operations
+	UpsertTradedDraftPick(ctx context.Context, arg db.UpsertTradedDraftPickParams) error
+	GetTradedDraftPicksFromSeason(ctx context.Context, season int32) ([]db.TradedDraftPick, error)
+
+	// Sleeper response cache operations
+	GetSleeperCacheEntry(ctx context.Context, key string) (db.SleeperCache, error)
+	UpsertSleeperCacheEntry(ctx context.Context, arg db.UpsertSleeperCacheEntryParams) error
 }
 
 // IDiscordSession wraps discordgo.Session for testing
@@ -49,37 +125,21 @@ type IDiscordSession interface {
 	InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error
 	GuildMember(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error)
 	ApplicationCommandCreate(appID string, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error)
+	ApplicationCommandBulkOverwrite(appID string, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error)
 	AddHandler(handler interface{}) func()
 	Open() error
 	Close() error
 	ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error)
 	ChannelMessageSend(channelID, content string) (*discordgo.Message, error)
-}
-
-// TestChain provides a dependency chain for testing with interfaces
-type TestChain struct {
-	DB            IDatabase
-	SleeperClient ISleeperClient
-	Discord       IDiscordSession
-}
-
-// NewTestChain creates a new test dependency chain with the provided mocks
-func NewTestChain(db IDatabase, sleeperClient ISleeperClient, discord IDiscordSession) *TestChain {
-	return &TestChain{
-		DB:            db,
-		SleeperClient: sleeperClient,
-		Discord:       discord,
-	}
-}
-
-// DatabaseWrapper wraps the real Queries struct to implement IDatabase
-type DatabaseWrapper struct {
-	*db.Queries
-}
-
-// NewDatabaseWrapper creates a wrapper around the real database queries
-func NewDatabaseWrapper(q *db.Queries) IDatabase {
-	return &DatabaseWrapper{Queries: q}
+	GuildMemberRoleAdd(guildID, userID, roleID string, options ...discordgo.RequestOption) error
+	GuildMemberNickname(guildID, userID, nickname string, options ...discordgo.RequestOption) error
+	ChannelMessageEditComplex(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	ChannelMessageDelete(channelID, messageID string, options ...discordgo.RequestOption) error
+	UserChannelCreate(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+	ThreadStartComplex(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error)
+	ThreadMemberAdd(threadID, memberID string, options ...discordgo.RequestOption) error
+	GuildMembers(guildID string, after string, limit int, options ...discordgo.RequestOption) ([]*discordgo.Member, error)
+	ChannelMessages(channelID string, limit int, beforeID, afterID, aroundID string, options ...discordgo.RequestOption) ([]*discordgo.Message, error)
 }
 
 // DiscordWrapper wraps the real discordgo.Session to implement IDiscordSession
@@ -101,12 +161,3 @@ func (d *DiscordWrapper) ChannelMessageSendComplex(channelID string, data *disco
 func (d *DiscordWrapper) ChannelMessageSend(channelID, content string) (*discordgo.Message, error) {
 	return d.Session.ChannelMessageSend(channelID, content)
 }
-
-// NewTestableChain converts a real Chain to use interfaces for testing compatibility
-func NewTestableChain(chain *Chain) *TestChain {
-	return &TestChain{
-		DB:            NewDatabaseWrapper(chain.DB),
-		SleeperClient: chain.SleeperClient,
-		Discord:       NewDiscordWrapper(chain.Discord),
-	}
-}
@@ -0,0 +1,189 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// autocompleteChoiceLimit is the maximum number of choices Discord shows for an autocomplete option
+const autocompleteChoiceLimit = 25
+
+// autocompleteChoiceNameLimit is the maximum length of an autocomplete choice's name
+const autocompleteChoiceNameLimit = 100
+
+// autocompleteTimeout keeps suggestions within Discord's 3 second deadline for autocomplete responses
+const autocompleteTimeout = 2 * time.Second
+
+// HandleAutocomplete suggests values for the "year", manager and franchise options as the user types a command
+func (h *Handler) HandleAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionApplicationCommandAutocomplete {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), autocompleteTimeout)
+	defer cancel()
+
+	focused := focusedOption(i.ApplicationCommandData().Options)
+	if focused == nil {
+		return
+	}
+	typed := strings.TrimSpace(fmt.Sprint(focused.Value))
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	switch focused.Name {
+	case "year":
+		years, err := h.interactor.GetLeagueYears(ctx)
+		if err != nil {
+			log.Printf("error getting league years for autocomplete: %v", err)
+		}
+		choices = yearChoices(years, typed)
+	case "manager", "home", "away":
+		users, err := h.interactor.GetUsers(ctx)
+		if err != nil {
+			log.Printf("error getting users for autocomplete: %v", err)
+		}
+		choices = managerChoices(users, typed)
+	case "franchise":
+		franchises, err := h.interactor.GetFranchises(ctx)
+		if err != nil {
+			log.Printf("error getting franchises for autocomplete: %v", err)
+		}
+		choices = franchiseChoices(franchises, typed)
+	default:
+		log.Printf("unknown autocomplete option %s for command %s", focused.Name, i.ApplicationCommandData().Name)
+	}
+
+	// Discord expects a non-nil list, even if nothing matches
+	if choices == nil {
+		choices = []*discordgo.ApplicationCommandOptionChoice{}
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	}); err != nil {
+		log.Printf("error responding to autocomplete: %v", err)
+	}
+}
+
+// focusedOption returns the option the user is currently typing, looking inside subcommands
+func focusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, opt := range options {
+		if opt.Focused {
+			return opt
+		}
+		if focused := focusedOption(opt.Options); focused != nil {
+			return focused
+		}
+	}
+	return nil
+}
+
+// yearChoices suggests league years starting with what has been typed so far, newest first
+func yearChoices(years []int, typed string) []*discordgo.ApplicationCommandOptionChoice {
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, year := range slices.Backward(years) {
+		name := strconv.Itoa(year)
+		if !strings.HasPrefix(name, typed) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: year})
+		if len(choices) == autocompleteChoiceLimit {
+			break
+		}
+	}
+	return choices
+}
+
+// managerChoices suggests managers whose name contains what has been typed so far, in alphabetical order.
+// The value of each choice is the manager's Sleeper user ID.
+func managerChoices(users domain.UserMap, typed string) []*discordgo.ApplicationCommandOptionChoice {
+	typed = strings.ToLower(typed)
+
+	var matches domain.Users
+	for _, user := range users {
+		if strings.Contains(strings.ToLower(user.Name), typed) {
+			matches = append(matches, user)
+		}
+	}
+	slices.SortFunc(matches, func(a, b domain.User) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, user := range matches {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: user.Name, Value: user.ID})
+		if len(choices) == autocompleteChoiceLimit {
+			break
+		}
+	}
+	return choices
+}
+
+// resolveManager returns the user a "manager" option refers to. Picking a suggestion sends the user's ID,
+// but the option also accepts a name typed out in full (ignoring case).
+func resolveManager(users domain.UserMap, value string) (domain.User, bool) {
+	if user, ok := users[value]; ok {
+		return user, true
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Name, strings.TrimSpace(value)) {
+			return user, true
+		}
+	}
+	return domain.User{}, false
+}
+
+// franchiseChoices suggests franchises whose team or manager names contain what has been typed so far, in alphabetical order.
+// The value of each choice is the franchise's Sleeper roster ID.
+func franchiseChoices(franchises domain.Franchises, typed string) []*discordgo.ApplicationCommandOptionChoice {
+	typed = strings.ToLower(typed)
+
+	var matches domain.Franchises
+	for _, f := range franchises {
+		if strings.Contains(strings.ToLower(f.DisplayName()), typed) || strings.Contains(strings.ToLower(f.ManagerNames()), typed) {
+			matches = append(matches, f)
+		}
+	}
+	slices.SortFunc(matches, func(a, b domain.Franchise) int {
+		return strings.Compare(strings.ToLower(a.DisplayName()), strings.ToLower(b.DisplayName()))
+	})
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, f := range matches {
+		name := f.DisplayName()
+		if f.Name != "" && len(f.Managers) > 0 {
+			name = fmt.Sprintf("%s (%s)", f.Name, f.ManagerNames())
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: truncate(name, autocompleteChoiceNameLimit), Value: strconv.Itoa(f.ID)})
+		if len(choices) == autocompleteChoiceLimit {
+			break
+		}
+	}
+	return choices
+}
+
+// resolveFranchise returns the franchise a "franchise" option refers to. Picking a suggestion sends the roster ID,
+// but the option also accepts a team name typed out in full (ignoring case).
+func resolveFranchise(franchises domain.Franchises, value string) (domain.Franchise, bool) {
+	if id, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		if f, ok := franchises.ForID(id); ok {
+			return f, true
+		}
+	}
+	for _, f := range franchises {
+		if strings.EqualFold(f.DisplayName(), strings.TrimSpace(value)) {
+			return f, true
+		}
+	}
+	return domain.Franchise{}, false
+}
@@ -0,0 +1,144 @@
+package discord
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYearChoices(t *testing.T) {
+	years := []int{2019, 2020, 2021, 2022, 2023, 2024}
+
+	tests := []struct {
+		name  string
+		typed string
+		want  []int
+	}{
+		{name: "nothing typed suggests every year newest first", typed: "", want: []int{2024, 2023, 2022, 2021, 2020, 2019}},
+		{name: "prefix filters years", typed: "202", want: []int{2024, 2023, 2022, 2021, 2020}},
+		{name: "exact year", typed: "2021", want: []int{2021}},
+		{name: "no match", typed: "1999", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			choices := yearChoices(years, tt.typed)
+
+			var got []int
+			for _, c := range choices {
+				assert.Equal(t, fmt.Sprint(c.Value), c.Name)
+				got = append(got, c.Value.(int))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestYearChoices_Limit(t *testing.T) {
+	var years []int
+	for year := 1990; year < 2030; year++ {
+		years = append(years, year)
+	}
+
+	choices := yearChoices(years, "")
+	require.Len(t, choices, autocompleteChoiceLimit)
+	assert.Equal(t, 2029, choices[0].Value)
+}
+
+func TestManagerChoices(t *testing.T) {
+	users := domain.UserMap{
+		"u1": {ID: "u1", Name: "Sam"},
+		"u2": {ID: "u2", Name: "samantha"},
+		"u3": {ID: "u3", Name: "Alex"},
+	}
+
+	choices := managerChoices(users, "SAM")
+	require.Len(t, choices, 2)
+	assert.Equal(t, "Sam", choices[0].Name)
+	assert.Equal(t, "u1", choices[0].Value)
+	assert.Equal(t, "samantha", choices[1].Name)
+	assert.Equal(t, "u2", choices[1].Value)
+
+	choices = managerChoices(users, "")
+	require.Len(t, choices, 3)
+	assert.Equal(t, "Alex", choices[0].Name)
+
+	assert.Empty(t, managerChoices(users, "zed"))
+}
+
+func TestResolveManager(t *testing.T) {
+	users := domain.UserMap{
+		"u1": {ID: "u1", Name: "Sam"},
+		"u2": {ID: "u2", Name: "Alex"},
+	}
+
+	user, ok := resolveManager(users, "u2")
+	assert.True(t, ok)
+	assert.Equal(t, "Alex", user.Name)
+
+	user, ok = resolveManager(users, " sam ")
+	assert.True(t, ok)
+	assert.Equal(t, "u1", user.ID)
+
+	_, ok = resolveManager(users, "Jordan")
+	assert.False(t, ok)
+}
+
+func TestFocusedOption(t *testing.T) {
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "user", Type: discordgo.ApplicationCommandOptionUser},
+		{Name: "subcommand", Type: discordgo.ApplicationCommandOptionSubCommand, Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "year", Type: discordgo.ApplicationCommandOptionInteger, Value: "20", Focused: true},
+		}},
+	}
+
+	focused := focusedOption(options)
+	require.NotNil(t, focused)
+	assert.Equal(t, "year", focused.Name)
+
+	assert.Nil(t, focusedOption(options[:1]))
+}
+
+func TestFranchiseChoices(t *testing.T) {
+	franchises := domain.Franchises{
+		{ID: 1, Name: "Thunder Bolts", Managers: domain.Users{{ID: "u1", Name: "Sam"}}},
+		{ID: 2, Name: "Dynasty Kings", Managers: domain.Users{{ID: "u2", Name: "Alex"}, {ID: "u3", Name: "Jordan"}}},
+		{ID: 3, Managers: domain.Users{{ID: "u4", Name: "Casey"}}},
+	}
+
+	choices := franchiseChoices(franchises, "")
+	require.Len(t, choices, 3)
+	assert.Equal(t, "Casey", choices[0].Name, "franchises without a team name are named after their managers")
+	assert.Equal(t, "3", choices[0].Value)
+	assert.Equal(t, "Dynasty Kings (Alex & Jordan)", choices[1].Name)
+	assert.Equal(t, "2", choices[1].Value)
+
+	choices = franchiseChoices(franchises, "jordan")
+	require.Len(t, choices, 1, "managers' names match too")
+	assert.Equal(t, "2", choices[0].Value)
+
+	assert.Empty(t, franchiseChoices(franchises, "zed"))
+}
+
+func TestResolveFranchise(t *testing.T) {
+	franchises := domain.Franchises{
+		{ID: 1, Name: "Thunder Bolts"},
+		{ID: 2, Name: "Dynasty Kings"},
+	}
+
+	franchise, ok := resolveFranchise(franchises, "2")
+	assert.True(t, ok)
+	assert.Equal(t, "Dynasty Kings", franchise.Name)
+
+	franchise, ok = resolveFranchise(franchises, " thunder bolts ")
+	assert.True(t, ok)
+	assert.Equal(t, 1, franchise.ID)
+
+	_, ok = resolveFranchise(franchises, "9")
+	assert.False(t, ok)
+}
@@ -3,27 +3,61 @@ package discord
 import (
 	"context"
 	"fmt"
-	"log"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 )
 
-func (h *Handler) handleCareerStatsCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	var targetUser *discordgo.User
-	for _, opt := range options {
-		if opt.Type == discordgo.ApplicationCommandOptionUser {
-			targetUser = opt.UserValue(s)
-			break
-		}
+func (h *Handler) careerStatsCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameCareerStats,
+			Description: "Get career stats for a specific user",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The user to get stats for",
+					Required:    false,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "manager",
+					Description:  "The manager to get stats for, even if they aren't in this server",
+					Required:     false,
+					Autocomplete: true,
+				},
+				franchiseOption("The team to get stats for, across everyone who has owned it", false),
+			},
+		},
+		handle: h.handleCareerStatsCommand,
 	}
+}
+
+func (h *Handler) handleCareerStatsCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	targetUser := opts.User(s, "user")
+	manager := opts.String("manager")
+
+	// A franchise's stats follow the team through every owner, rather than one person
+	if franchise := opts.String("franchise"); franchise != "" {
+		h.handleCareerStatsForFranchise(ctx, s, i, franchise)
+		return
+	}
+
+	// A manager can be looked up even if they've never joined the server or linked their account
+	if manager != "" {
+		h.handleCareerStatsForManager(ctx, s, i, manager)
+		return
+	}
+
 	if targetUser == nil {
+		h.Respond(s, i, "Please choose a user, a manager or a team.")
 		return
 	}
 
 	member, err := s.GuildMember(i.GuildID, targetUser.ID)
 	if err != nil {
-		h.Respond(s, i, "Hmm... I couldn't find that user in this server.")
+		h.RespondError(s, i, "Hmm... I couldn't find that user in this server.", err)
 		return
 	}
 
@@ -36,9 +70,79 @@ func (h *Handler) handleCareerStatsCommand(ctx context.Context, s *discordgo.Ses
 
 	stats, err := h.interactor.GetCareerStatsForDiscordUser(ctx, targetUser.ID)
 	if err != nil {
-		log.Printf("error querying supabase: %s", err.Error())
-		h.Respond(s, i, fmt.Sprintf("Hmm... I couldn't find any status for %s.", targetUser.Username))
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't find any status for %s.", targetUser.Username), err)
+		return
+	}
+	h.RespondEmbeds(s, i, careerStatsEmbed(stats, displayName, h.avatarURL(ctx, stats.UserID)))
+}
+
+// handleCareerStatsForManager responds with the career stats of a manager chosen by Sleeper user ID or name
+func (h *Handler) handleCareerStatsForManager(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, manager string) {
+	users, err := h.interactor.GetUsers(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get users.", err)
+		return
+	}
+
+	user, ok := resolveManager(users, manager)
+	if !ok {
+		h.Respond(s, i, fmt.Sprintf("Hmm... I couldn't find a manager named %s.", manager))
+		return
+	}
+
+	stats, err := h.interactor.GetCareerStatsForUser(ctx, user.ID)
+	if err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't find any status for %s.", user.Name), err)
+		return
+	}
+	h.RespondEmbeds(s, i, careerStatsEmbed(stats, user.Name, h.avatarURL(ctx, stats.UserID)))
+}
+
+// handleCareerStatsForFranchise responds with the career stats of a franchise chosen by roster ID or team name
+func (h *Handler) handleCareerStatsForFranchise(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, value string) {
+	franchise, ok := h.franchise(ctx, s, i, value)
+	if !ok {
+		return
+	}
+
+	stats, err := h.interactor.GetCareerStatsForFranchise(ctx, franchise.ID)
+	if err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't find any seasons for %s.", franchise.DisplayName()), err)
 		return
 	}
-	h.Respond(s, i, stats.ToDiscordMessage(displayName))
+	h.RespondEmbeds(s, i, careerStatsEmbed(stats, franchise.DisplayName(), h.avatarURL(ctx, franchise.OwnerID)))
+}
+
+// careerStatsEmbed renders a user's career stats as an embed with one field per section
+func careerStatsEmbed(stats domain.CareerStats, displayName string, avatarURL string) *discordgo.MessageEmbed {
+	e := withThumbnail(newEmbed(fmt.Sprintf("%s's Career Stats 📊", displayName)), avatarURL)
+	e.Description = franchiseDescription(stats)
+
+	playoffs := "🎯 Playoffs"
+	if stats.PlayoffAppearances > 0 {
+		playoffs = fmt.Sprintf("🎯 Playoffs: %s (%d appearances)", stats.PlayoffRecord, stats.PlayoffAppearances)
+	}
+
+	e.Fields = []*discordgo.MessageEmbedField{
+		{Name: "🏆 Trophy Case", Value: stats.TrophyCase()},
+		{Name: "💵 Career Earnings", Value: stats.CareerEarningsSummary(displayName)},
+		{Name: fmt.Sprintf("🏟️ Regular Season: %s", stats.RegularSeasonRecord), Value: stats.RegularSeasonSummary()},
+		{Name: playoffs, Value: stats.PlayoffSummary()},
+	}
+	return e
+}
+
+// franchiseDescription credits everyone who has owned a franchise whose stats follow the team,
+// or everyone managing a co-owned franchise, whose stats are shared
+func franchiseDescription(stats domain.CareerStats) string {
+	if stats.Owners != "" {
+		return fmt.Sprintf("📜 Owned by %s", stats.Owners)
+	}
+	if stats.Managers == "" {
+		return ""
+	}
+	if stats.FranchiseName == "" {
+		return fmt.Sprintf("🤝 Co-managed by %s", stats.Managers)
+	}
+	return fmt.Sprintf("🤝 **%s**, co-managed by %s", stats.FranchiseName, stats.Managers)
 }
@@ -24,6 +24,21 @@ func NewChannelPoster(session *discordgo.Session, channelID string) *ChannelPost
 
 // PostWeeklySummary posts a weekly summary message to the configured Discord channel
 func (p *ChannelPoster) PostWeeklySummary(ctx context.Context, summary string) error {
+	return p.post(ctx, summary)
+}
+
+// PostSeasonAwards posts the end-of-season awards ceremony to the configured Discord channel
+func (p *ChannelPoster) PostSeasonAwards(ctx context.Context, awards string) error {
+	return p.post(ctx, awards)
+}
+
+// PostTransaction posts a trade, waiver claim or free agent move to the configured Discord channel
+func (p *ChannelPoster) PostTransaction(ctx context.Context, transaction string) error {
+	return p.post(ctx, transaction)
+}
+
+// post sends a message to the configured Discord channel, retrying on failure
+func (p *ChannelPoster) post(ctx context.Context, content string) error {
 	// Open Discord connection if not already open
 	if !p.session.DataReady {
 		if err := p.session.Open(); err != nil {
@@ -37,7 +52,7 @@ func (p *ChannelPoster) PostWeeklySummary(ctx context.Context, summary string) e
 	var lastErr error
 
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		_, err := p.session.ChannelMessageSend(p.channelID, summary)
+		_, err := p.session.ChannelMessageSend(p.channelID, content)
 		if err == nil {
 			return nil // Success
 		}
@@ -0,0 +1,129 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// command is a slash command: the definition registered with Discord and the handler that runs it.
+// Ephemeral commands respond privately, so only the member who ran them can see the response.
+type command struct {
+	definition *discordgo.ApplicationCommand
+	handle     commandHandler
+	ephemeral  bool
+}
+
+// commandHandler runs a slash command after its response has been deferred
+type commandHandler func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions)
+
+// commandRegistry returns every slash command the bot supports.
+// Discord's commands are overwritten with this list on startup, so removing a command here also removes it from Discord.
+func (h *Handler) commandRegistry() []command {
+	return []command{
+		h.careerStatsCommand(),
+		h.franchiseHistoryCommand(),
+		h.standingsCommand(),
+		h.weeklySummaryCommand(),
+		h.ledgerCommand(),
+		h.tradesCommand(),
+		h.draftCommand(),
+		h.keepersCommand(),
+		h.waiversCommand(),
+		h.duesCommand(),
+		h.duesPaidCommand(),
+		h.commishCommand(),
+		h.linkCommand(),
+		h.unlinkCommand(),
+		h.whoamiCommand(),
+		h.emailCommand(),
+	}
+}
+
+// commandsByName indexes commands by name for dispatching interactions
+func commandsByName(commands []command) map[string]command {
+	byName := make(map[string]command, len(commands))
+	for _, c := range commands {
+		byName[c.definition.Name] = c
+	}
+	return byName
+}
+
+// registerCommands registers Discord bot commands that are accessible with slash commands (i.e. "/standings 2024").
+// The guild's commands are replaced in a single bulk overwrite, so commands that no longer exist in code are deleted.
+func registerCommands(session dependency.IDiscordSession, appID, guildID string, commands []command) error {
+	definitions := make([]*discordgo.ApplicationCommand, len(commands))
+	for idx, c := range commands {
+		definitions[idx] = c.definition
+	}
+
+	registered, err := session.ApplicationCommandBulkOverwrite(appID, guildID, definitions)
+	if err != nil {
+		return fmt.Errorf("failed to overwrite commands: %w", err)
+	}
+
+	for _, c := range registered {
+		log.Printf("registered command: %s", c.Name)
+	}
+	return nil
+}
+
+// commandOptions are the options a command was invoked with, by name
+type commandOptions map[string]*discordgo.ApplicationCommandInteractionDataOption
+
+func newCommandOptions(options []*discordgo.ApplicationCommandInteractionDataOption) commandOptions {
+	opts := make(commandOptions, len(options))
+	for _, opt := range options {
+		opts[opt.Name] = opt
+	}
+	return opts
+}
+
+// Int returns the value of an integer option and whether it was given
+func (o commandOptions) Int(name string) (int, bool) {
+	opt, ok := o[name]
+	if !ok {
+		return 0, false
+	}
+	return int(opt.IntValue()), true
+}
+
+// Float returns the value of a number option and whether it was given
+func (o commandOptions) Float(name string) (float64, bool) {
+	opt, ok := o[name]
+	if !ok {
+		return 0, false
+	}
+	return opt.FloatValue(), true
+}
+
+// String returns the value of a string option, or an empty string if it wasn't given
+func (o commandOptions) String(name string) string {
+	opt, ok := o[name]
+	if !ok {
+		return ""
+	}
+	return opt.StringValue()
+}
+
+// Bool returns the value of a boolean option, or fallback if it wasn't given
+func (o commandOptions) Bool(name string, fallback bool) bool {
+	opt, ok := o[name]
+	if !ok {
+		return fallback
+	}
+	return opt.BoolValue()
+}
+
+// User returns the user of a user option, or nil if it wasn't given
+func (o commandOptions) User(s *discordgo.Session, name string) *discordgo.User {
+	opt, ok := o[name]
+	if !ok {
+		return nil
+	}
+	return opt.UserValue(s)
+}
@@ -0,0 +1,91 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandRegistry(t *testing.T) {
+	commands := (&Handler{}).commandRegistry()
+	byName := commandsByName(commands)
+
+	// Every command needs a unique name, or one would silently replace another in Discord
+	assert.Len(t, byName, len(commands))
+
+	for _, c := range commands {
+		require.NotNil(t, c.definition)
+		assert.NotEmpty(t, c.definition.Name)
+		assert.NotEmpty(t, c.definition.Description, c.definition.Name)
+		assert.NotNil(t, c.handle, c.definition.Name)
+	}
+
+	for _, name := range []string{commandNameCareerStats, commandNameStandings, commandNameWeeklySummary, commandNameLedger, commandNameDues, commandNameDuesPaid, commandNameLink, commandNameUnlink, commandNameWhoami, commandNameEmail, commandNameFranchise, commandNameTrades, commandNameDraft, commandNameKeepers, commandNameWaivers} {
+		assert.Contains(t, byName, name)
+	}
+}
+
+func TestRegisterCommands(t *testing.T) {
+	commands := (&Handler{}).commandRegistry()
+
+	t.Run("overwrites every command at once", func(t *testing.T) {
+		var gotAppID, gotGuildID string
+		var got []*discordgo.ApplicationCommand
+		session := &dependency.MockDiscordSession{
+			ApplicationCommandBulkOverwriteFunc: func(appID string, guildID string, cmds []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+				gotAppID, gotGuildID, got = appID, guildID, cmds
+				return cmds, nil
+			},
+		}
+
+		require.NoError(t, registerCommands(session, "app", "guild", commands))
+		assert.Equal(t, "app", gotAppID)
+		assert.Equal(t, "guild", gotGuildID)
+		require.Len(t, got, len(commands))
+		for idx, c := range commands {
+			assert.Same(t, c.definition, got[idx])
+		}
+	})
+
+	t.Run("returns overwrite errors", func(t *testing.T) {
+		session := &dependency.MockDiscordSession{
+			ApplicationCommandBulkOverwriteFunc: func(appID string, guildID string, cmds []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+				return nil, assert.AnError
+			},
+		}
+
+		err := registerCommands(session, "app", "guild", commands)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestCommandOptions(t *testing.T) {
+	opts := newCommandOptions([]*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "year", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(2024)},
+		{Name: "manager", Type: discordgo.ApplicationCommandOptionString, Value: "u1"},
+		{Name: "paid", Type: discordgo.ApplicationCommandOptionBoolean, Value: false},
+		{Name: "user", Type: discordgo.ApplicationCommandOptionUser, Value: "123"},
+	})
+
+	year, ok := opts.Int("year")
+	assert.True(t, ok)
+	assert.Equal(t, 2024, year)
+
+	_, ok = opts.Int("amount")
+	assert.False(t, ok)
+
+	assert.Equal(t, "u1", opts.String("manager"))
+	assert.Empty(t, opts.String("missing"))
+
+	assert.False(t, opts.Bool("paid", true))
+	assert.True(t, opts.Bool("missing", true))
+
+	user := opts.User(nil, "user")
+	require.NotNil(t, user)
+	assert.Equal(t, "123", user.ID)
+	assert.Nil(t, opts.User(nil, "missing"))
+}
@@ -0,0 +1,536 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/internal/format"
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Subcommands of /commish
+const (
+	commishSync           = "sync"
+	commishRepostRecap    = "repost-recap"
+	commishSetEmail       = "set-email"
+	commishLink           = "link"
+	commishUnlink         = "unlink"
+	commishLeagueStatus   = "league-status"
+	commishCorrectMatchup = "correct-matchup"
+	commishAudit          = "audit"
+	commishSyncFranchises = "sync-franchises"
+	commishSettleLedger   = "settle-ledger"
+	commishPayouts        = "payouts"
+	commishAdjust         = "adjust"
+)
+
+// defaultAuditLogLimit is how many admin actions /commish audit shows by default
+const defaultAuditLogLimit = 15
+
+func (h *Handler) commishCommand() command {
+	yearOption := func(description string, required bool) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{
+			Type:         discordgo.ApplicationCommandOptionInteger,
+			Name:         "year",
+			Description:  description,
+			Required:     required,
+			Autocomplete: true,
+		}
+	}
+	managerOption := func(name, description string) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{
+			Type:         discordgo.ApplicationCommandOptionString,
+			Name:         name,
+			Description:  description,
+			Required:     true,
+			Autocomplete: true,
+		}
+	}
+
+	zero := 0.0
+	minOne := 1.0
+
+	dollarsOption := func(name, description string) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        name,
+			Description: description,
+			Required:    true,
+			MinValue:    &zero,
+		}
+	}
+
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:                     commandNameCommish,
+			Description:              "Commissioner tools",
+			DefaultMemberPermissions: &adminPermissions,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishSync,
+					Description: "Sync matchups, player scores, transactions, the draft and traded picks from Sleeper now",
+					Options:     []*discordgo.ApplicationCommandOption{yearOption("The year to sync (defaults to the latest league)", false)},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishSyncFranchises,
+					Description: "Record who owned each team in every season from Sleeper",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishSettleLedger,
+					Description: "Record the buy-ins and payouts of every season in the ledger",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishPayouts,
+					Description: "Set the buy-in and payouts for a season",
+					Options: []*discordgo.ApplicationCommandOption{
+						yearOption("The year the payouts are for", true),
+						dollarsOption("buy-in", "The buy-in each member owes, in dollars"),
+						dollarsOption("weekly-high-score", "The payout for each regular season weekly high score, in dollars"),
+						dollarsOption("first", "The champion's payout, in dollars"),
+						dollarsOption("second", "The runner-up's payout, in dollars"),
+						dollarsOption("third", "The third place payout, in dollars"),
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishAdjust,
+					Description: "Add or take money from a manager's balance for a season",
+					Options: []*discordgo.ApplicationCommandOption{
+						managerOption("manager", "The manager to adjust the balance of"),
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Dollars to add, or a negative amount to take away",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "note",
+							Description: "Why the balance is being adjusted, e.g. late fee",
+							Required:    true,
+						},
+						yearOption("The year of the adjustment (defaults to the latest league)", false),
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishRepostRecap,
+					Description: "Re-post the latest weekly recap",
+					Options:     []*discordgo.ApplicationCommandOption{yearOption("The year to post the recap for (defaults to the latest league)", false)},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishSetEmail,
+					Description: "Set the email a manager's recaps are sent to",
+					Options: []*discordgo.ApplicationCommandOption{
+						managerOption("manager", "The manager to set the email for"),
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "email",
+							Description: "The email address, or \"none\" to stop sending emails",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishLink,
+					Description: "Link a Discord user to a manager's Sleeper account",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "The Discord user",
+							Required:    true,
+						},
+						managerOption("manager", "The manager to link the user to"),
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishUnlink,
+					Description: "Unlink a manager's Sleeper account from their Discord user",
+					Options:     []*discordgo.ApplicationCommandOption{managerOption("manager", "The manager to unlink")},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishLeagueStatus,
+					Description: "Change the status of a league",
+					Options: []*discordgo.ApplicationCommandOption{
+						yearOption("The year of the league", true),
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "status",
+							Description: "The new status",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Pending", Value: domain.LeagueStatusPending},
+								{Name: "In Progress", Value: domain.LeagueStatusInProgress},
+								{Name: "Complete", Value: domain.LeagueStatusComplete},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishCorrectMatchup,
+					Description: "Correct the scores of a matchup",
+					Options: []*discordgo.ApplicationCommandOption{
+						yearOption("The year of the matchup", true),
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "week",
+							Description: "The week of the matchup",
+							Required:    true,
+							MinValue:    &minOne,
+						},
+						managerOption("home", "The home manager"),
+						managerOption("away", "The away manager"),
+						{
+							Type:        discordgo.ApplicationCommandOptionNumber,
+							Name:        "home-score",
+							Description: "The home manager's corrected score",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionNumber,
+							Name:        "away-score",
+							Description: "The away manager's corrected score",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        commishAudit,
+					Description: "Show the most recent commissioner actions",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "limit",
+							Description: fmt.Sprintf("How many actions to show (defaults to %d)", defaultAuditLogLimit),
+							Required:    false,
+							MinValue:    &minOne,
+							MaxValue:    50,
+						},
+					},
+				},
+			},
+		},
+		handle: h.handleCommishCommand,
+	}
+}
+
+// handleCommishCommand handles the /commish Discord command, running the chosen subcommand for the commissioner
+func (h *Handler) handleCommishCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	if !h.isCommissioner(i.Member) {
+		h.Respond(s, i, "🚫 Only the commissioner can use this command.")
+		return
+	}
+
+	sub := subcommand(i.ApplicationCommandData().Options)
+	if sub == nil {
+		h.Respond(s, i, "Please choose a subcommand.")
+		return
+	}
+	subOpts := newCommandOptions(sub.Options)
+	actorID := i.Member.User.ID
+
+	log.Printf("commissioner %s running /commish %s", actorID, sub.Name)
+
+	switch sub.Name {
+	case commishSync:
+		h.handleCommishSync(ctx, s, i, subOpts, actorID)
+	case commishSyncFranchises:
+		h.handleCommishSyncFranchises(ctx, s, i, actorID)
+	case commishSettleLedger:
+		h.handleCommishSettleLedger(ctx, s, i, actorID)
+	case commishPayouts:
+		h.handleCommishPayouts(ctx, s, i, subOpts, actorID)
+	case commishAdjust:
+		h.handleCommishAdjust(ctx, s, i, subOpts, actorID)
+	case commishRepostRecap:
+		h.handleCommishRepostRecap(ctx, s, i, subOpts, actorID)
+	case commishSetEmail:
+		h.handleCommishSetEmail(ctx, s, i, subOpts, actorID)
+	case commishLink:
+		h.handleCommishLink(ctx, s, i, subOpts, actorID)
+	case commishUnlink:
+		h.handleCommishUnlink(ctx, s, i, subOpts, actorID)
+	case commishLeagueStatus:
+		h.handleCommishLeagueStatus(ctx, s, i, subOpts, actorID)
+	case commishCorrectMatchup:
+		h.handleCommishCorrectMatchup(ctx, s, i, subOpts, actorID)
+	case commishAudit:
+		h.handleCommishAudit(ctx, s, i, subOpts)
+	default:
+		h.Respond(s, i, "Hmm... I don't know that subcommand.")
+	}
+}
+
+// isCommissioner reports whether a member can use /commish. Discord only hides the command from members without
+// Manage Server by default, and server admins can grant it to anyone, so permissions are checked again here.
+// If a commissioner role is configured it is required, otherwise the Manage Server permission is.
+func (h *Handler) isCommissioner(member *discordgo.Member) bool {
+	if member == nil || member.User == nil {
+		return false
+	}
+	if h.commissionerRoleID != "" {
+		return slices.Contains(member.Roles, h.commissionerRoleID)
+	}
+	return member.Permissions&adminPermissions != 0
+}
+
+// subcommand returns the subcommand a command was invoked with
+func subcommand(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, opt := range options {
+		if opt.Type == discordgo.ApplicationCommandOptionSubCommand {
+			return opt
+		}
+	}
+	return nil
+}
+
+// manager returns the user a manager option refers to.
+// It responds to the interaction and returns false if the manager can't be found.
+func (h *Handler) manager(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, name string) (domain.User, bool) {
+	users, err := h.interactor.GetUsers(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get users.", err)
+		return domain.User{}, false
+	}
+
+	user, ok := resolveManager(users, opts.String(name))
+	if !ok {
+		h.Respond(s, i, fmt.Sprintf("Hmm... I couldn't find a manager named %s.", opts.String(name)))
+		return domain.User{}, false
+	}
+	return user, true
+}
+
+func (h *Handler) handleCommishSync(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	if err := h.interactor.ForceSync(ctx, actorID, year); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't sync %d.", year), err)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ Synced %d from Sleeper.", year))
+}
+
+func (h *Handler) handleCommishSyncFranchises(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, actorID string) {
+	if err := h.interactor.SyncFranchiseHistory(ctx, actorID); err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't sync franchise owners.", err)
+		return
+	}
+	h.Respond(s, i, "✅ Recorded who owned each team in every season from Sleeper.")
+}
+
+func (h *Handler) handleCommishSettleLedger(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, actorID string) {
+	if err := h.interactor.SettleLedgerHistory(ctx, actorID); err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't settle the ledger.", err)
+		return
+	}
+	h.Respond(s, i, "✅ Recorded the buy-ins and payouts of every season in the ledger.")
+}
+
+func (h *Handler) handleCommishPayouts(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	var rules domain.PayoutRules
+	rules.Year, _ = opts.Int("year")
+	rules.BuyIn, _ = opts.Int("buy-in")
+	rules.WeeklyHighScore, _ = opts.Int("weekly-high-score")
+	rules.FirstPlace, _ = opts.Int("first")
+	rules.SecondPlace, _ = opts.Int("second")
+	rules.ThirdPlace, _ = opts.Int("third")
+
+	if err := h.interactor.SetPayoutRules(ctx, actorID, rules); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't set the payouts for %d.", rules.Year), err)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ %d: $%d buy-in, $%d per weekly high score, and $%d / $%d / $%d for the podium.",
+		rules.Year, rules.BuyIn, rules.WeeklyHighScore, rules.FirstPlace, rules.SecondPlace, rules.ThirdPlace))
+}
+
+func (h *Handler) handleCommishAdjust(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	user, ok := h.manager(ctx, s, i, opts, "manager")
+	if !ok {
+		return
+	}
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	adjustment := interactor.LedgerAdjustment{Year: year, UserID: user.ID, Note: strings.TrimSpace(opts.String("note"))}
+	adjustment.Amount, _ = opts.Int("amount")
+
+	if err := h.interactor.AdjustLedger(ctx, actorID, adjustment); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't adjust %s's balance.", user.Name), err)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ Recorded %s for %s in %d (%s).", domain.FormatDollars(adjustment.Amount), user.Name, year, adjustment.Note))
+}
+
+func (h *Handler) handleCommishRepostRecap(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	summary, err := h.interactor.GenerateWeeklySummary(ctx, year)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't generate the weekly recap.", err)
+		return
+	}
+
+	users, err := h.interactor.GetUsers(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get users.", err)
+		return
+	}
+
+	channelID := h.weeklyRecapChannelID
+	if channelID == "" {
+		channelID = i.ChannelID
+	}
+
+	if err := NewChannelPoster(s, channelID).PostWeeklySummary(ctx, format.WeeklySummary(summary, users)); err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't post the weekly recap.", err)
+		return
+	}
+
+	details := fmt.Sprintf("%d week %d to <#%s>", year, summary.Week, channelID)
+	if err := h.interactor.RecordAdminAction(ctx, actorID, domain.AdminActionRepostRecap, details); err != nil {
+		h.RespondError(s, i, "The recap was posted, but I couldn't record it in the audit log.", err)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ Re-posted the week %d recap in <#%s>.", summary.Week, channelID))
+}
+
+func (h *Handler) handleCommishSetEmail(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	user, ok := h.manager(ctx, s, i, opts, "manager")
+	if !ok {
+		return
+	}
+
+	email := strings.TrimSpace(opts.String("email"))
+	if strings.EqualFold(email, "none") {
+		email = ""
+	}
+
+	if err := h.interactor.SetUserEmail(ctx, actorID, user.ID, email); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't set the email for %s.", user.Name), err)
+		return
+	}
+
+	if email == "" {
+		h.Respond(s, i, fmt.Sprintf("✅ %s will no longer receive emails.", user.Name))
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ %s's emails will be sent to %s.", user.Name, email))
+}
+
+func (h *Handler) handleCommishLink(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	targetUser := opts.User(s, "user")
+	if targetUser == nil {
+		h.Respond(s, i, "Please choose a user.")
+		return
+	}
+
+	user, ok := h.manager(ctx, s, i, opts, "manager")
+	if !ok {
+		return
+	}
+
+	if err := h.interactor.LinkUser(ctx, actorID, user.ID, targetUser.ID); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't link %s to %s.", targetUser.Username, user.Name), err)
+		return
+	}
+
+	h.onAccountLinked(ctx, targetUser.ID)
+
+	if err := h.completeOnboarding(ctx, dependency.NewDiscordWrapper(s), targetUser.ID); err != nil {
+		_, components := onboardingOutcome(targetUser.ID, err)
+		h.respondConfirmation(s, i, fmt.Sprintf("⚠️ Linked %s to %s, but I couldn't finish setting them up in the server: %v",
+			targetUser.Mention(), user.Name, err), components)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ Linked %s to %s.", targetUser.Mention(), user.Name))
+}
+
+func (h *Handler) handleCommishUnlink(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	user, ok := h.manager(ctx, s, i, opts, "manager")
+	if !ok {
+		return
+	}
+
+	if err := h.interactor.UnlinkUser(ctx, actorID, user.ID); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't unlink %s.", user.Name), err)
+		return
+	}
+	h.refreshWelcomeMessages(ctx)
+	h.Respond(s, i, fmt.Sprintf("✅ Unlinked %s from their Discord account.", user.Name))
+}
+
+func (h *Handler) handleCommishLeagueStatus(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	year, _ := opts.Int("year")
+	status := opts.String("status")
+
+	if err := h.interactor.SetLeagueStatus(ctx, actorID, year, status); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't update the %d league.", year), err)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ The %d league is now %s.", year, status))
+}
+
+func (h *Handler) handleCommishCorrectMatchup(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions, actorID string) {
+	home, ok := h.manager(ctx, s, i, opts, "home")
+	if !ok {
+		return
+	}
+	away, ok := h.manager(ctx, s, i, opts, "away")
+	if !ok {
+		return
+	}
+
+	correction := interactor.MatchupCorrection{HomeUserID: home.ID, AwayUserID: away.ID}
+	correction.Year, _ = opts.Int("year")
+	correction.Week, _ = opts.Int("week")
+	correction.HomeScore, _ = opts.Float("home-score")
+	correction.AwayScore, _ = opts.Float("away-score")
+
+	if err := h.interactor.CorrectMatchup(ctx, actorID, correction); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't correct %s vs %s in week %d of %d.", home.Name, away.Name, correction.Week, correction.Year), err)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ Week %d of %d is now %s %.2f - %.2f %s.",
+		correction.Week, correction.Year, home.Name, correction.HomeScore, correction.AwayScore, away.Name))
+}
+
+func (h *Handler) handleCommishAudit(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	limit, ok := opts.Int("limit")
+	if !ok {
+		limit = defaultAuditLogLimit
+	}
+
+	auditLog, err := h.interactor.GetAdminAuditLog(ctx, limit)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get the audit log.", err)
+		return
+	}
+	h.Respond(s, i, auditLog.ToDiscordMessage())
+}
@@ -0,0 +1,75 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCommissioner(t *testing.T) {
+	member := func(permissions int64, roles ...string) *discordgo.Member {
+		return &discordgo.Member{User: TestUser("123", "commish"), Permissions: permissions, Roles: roles}
+	}
+
+	tests := []struct {
+		name               string
+		commissionerRoleID string
+		member             *discordgo.Member
+		want               bool
+	}{
+		{name: "manage server without a configured role", member: member(discordgo.PermissionManageGuild), want: true},
+		{name: "no permissions without a configured role", member: member(discordgo.PermissionSendMessages), want: false},
+		{name: "configured role", commissionerRoleID: "role1", member: member(0, "role0", "role1"), want: true},
+		{name: "manage server is not enough with a configured role", commissionerRoleID: "role1", member: member(discordgo.PermissionManageGuild, "role0"), want: false},
+		{name: "direct message", member: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{commissionerRoleID: tt.commissionerRoleID}
+			assert.Equal(t, tt.want, h.isCommissioner(tt.member))
+		})
+	}
+}
+
+func TestSubcommand(t *testing.T) {
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{
+			Name: commishCorrectMatchup,
+			Type: discordgo.ApplicationCommandOptionSubCommand,
+			Options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "year", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(2024)},
+				{Name: "home-score", Type: discordgo.ApplicationCommandOptionNumber, Value: 101.5},
+			},
+		},
+	}
+
+	sub := subcommand(options)
+	require.NotNil(t, sub)
+	assert.Equal(t, commishCorrectMatchup, sub.Name)
+
+	opts := newCommandOptions(sub.Options)
+	year, _ := opts.Int("year")
+	assert.Equal(t, 2024, year)
+	score, ok := opts.Float("home-score")
+	assert.True(t, ok)
+	assert.Equal(t, 101.5, score)
+
+	assert.Nil(t, subcommand(sub.Options))
+}
+
+func TestCommishCommand_SubcommandsAreAdminOnly(t *testing.T) {
+	c := (&Handler{}).commishCommand()
+
+	require.NotNil(t, c.definition.DefaultMemberPermissions)
+	assert.Equal(t, int64(discordgo.PermissionManageGuild), *c.definition.DefaultMemberPermissions)
+
+	var names []string
+	for _, opt := range c.definition.Options {
+		assert.Equal(t, discordgo.ApplicationCommandOptionSubCommand, opt.Type)
+		names = append(names, opt.Name)
+	}
+	assert.ElementsMatch(t, []string{commishSync, commishRepostRecap, commishSetEmail, commishLink, commishUnlink, commishLeagueStatus, commishCorrectMatchup, commishAudit, commishSyncFranchises, commishSettleLedger, commishPayouts, commishAdjust}, names)
+}
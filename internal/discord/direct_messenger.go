@@ -0,0 +1,38 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// DirectMessenger handles sending direct messages to Discord users
+type DirectMessenger struct {
+	session *discordgo.Session
+}
+
+// NewDirectMessenger creates a new direct messenger
+func NewDirectMessenger(session *discordgo.Session) *DirectMessenger {
+	return &DirectMessenger{
+		session: session,
+	}
+}
+
+// SendDirectMessage sends a message to a Discord user's DM channel
+func (m *DirectMessenger) SendDirectMessage(ctx context.Context, discordUserID string, content string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("operation canceled: %w", err)
+	}
+
+	channel, err := m.session.UserChannelCreate(discordUserID)
+	if err != nil {
+		return fmt.Errorf("failed to open DM channel: %w", err)
+	}
+
+	if _, err := m.session.ChannelMessageSend(channel.ID, content); err != nil {
+		return fmt.Errorf("failed to send DM: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+func (h *Handler) draftCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameDraft,
+			Description: "Show a season's draft picks and how each player performed against where they were picked",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year of the draft (defaults to the latest league)",
+					Required:     false,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "Only show this user's picks",
+					Required:    false,
+				},
+			},
+		},
+		handle: h.handleDraftCommand,
+	}
+}
+
+// handleDraftCommand handles the /draft Discord command, listing a season's picks with their draft value
+func (h *Handler) handleDraftCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	targetUser := opts.User(s, "user")
+
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	title := fmt.Sprintf("%d Draft 📝", year)
+	var report domain.DraftReport
+	var err error
+	if targetUser == nil {
+		report, err = h.interactor.GetDraftReport(ctx, year)
+	} else {
+		title = fmt.Sprintf("%s's %d Draft 📝", targetUser.Username, year)
+		report, err = h.interactor.GetDraftReportForDiscordUser(ctx, targetUser.ID, year)
+	}
+	if err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't get the %d draft.", year), err)
+		return
+	}
+
+	users, err := h.interactor.GetManagerNames(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get users.", err)
+		return
+	}
+
+	h.RespondEmbeds(s, i, draftEmbed(title, report, users))
+}
+
+// draftEmbed renders a draft report as an embed with the steal and bust up top and one field per round
+func draftEmbed(title string, report domain.DraftReport, users domain.UserMap) *discordgo.MessageEmbed {
+	e := newEmbed(title)
+	if len(report.Picks) == 0 {
+		e.Description = "No draft picks have been recorded. The commissioner can record them with `/commish sync`."
+		return e
+	}
+
+	var b strings.Builder
+	b.WriteString("Each pick shows the player's season points and where they finished among every drafted player.\n")
+	if report.Steal != nil {
+		fmt.Fprintf(&b, "\n💎 **Steal of the Draft**: %s\n", draftPickLine(*report.Steal, users))
+	}
+	if report.Bust != nil {
+		fmt.Fprintf(&b, "📉 **Biggest Bust**: %s\n", draftPickLine(*report.Bust, users))
+	}
+	e.Description = b.String()
+
+	var field *discordgo.MessageEmbedField
+	for _, v := range report.Picks {
+		if field == nil || field.Name != fmt.Sprintf("Round %d", v.Pick.Round) {
+			field = &discordgo.MessageEmbedField{Name: fmt.Sprintf("Round %d", v.Pick.Round)}
+			e.Fields = append(e.Fields, field)
+		}
+		field.Value += draftPickLine(v, users) + "\n"
+	}
+	return e
+}
+
+// draftPickLine describes a pick and its value (e.g. "`3.07` **Sam** - Player A (RB, PHI): 180.50 pts, #12 (▲ 19)")
+func draftPickLine(v domain.DraftPickValue, users domain.UserMap) string {
+	line := fmt.Sprintf("`%s` **%s** - %s: %.2f pts, #%d", v.Pick.Label(), managerName(users, v.Pick.UserID), v.Pick.Player.Description(), v.Points, v.Rank)
+	switch value := v.Value(); {
+	case v.Pick.IsKeeper:
+		line += " 🔒"
+	case value > 0:
+		line += fmt.Sprintf(" (▲ %d)", value)
+	case value < 0:
+		line += fmt.Sprintf(" (▼ %d)", -value)
+	}
+	return line
+}
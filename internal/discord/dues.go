@@ -0,0 +1,150 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (h *Handler) duesCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameDues,
+			Description: "See who still owes their buy-in for a specific year",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year to get dues for",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		handle: h.handleDuesCommand,
+	}
+}
+
+func (h *Handler) duesPaidCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:                     commandNameDuesPaid,
+			Description:              "Mark a member's buy-in as paid (commissioner only)",
+			DefaultMemberPermissions: &adminPermissions,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The member who paid",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "amount",
+					Description: "The amount paid (defaults to the full buy-in)",
+					Required:    false,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year the dues are for",
+					Required:     false,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "paid",
+					Description: "Set to false to undo a payment",
+					Required:    false,
+				},
+			},
+		},
+		handle: h.handleDuesPaidCommand,
+	}
+}
+
+// handleDuesCommand handles the /dues Discord command, showing who still owes their buy-in.
+func (h *Handler) handleDuesCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	statuses, err := h.interactor.GetDuesStatus(ctx, year)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get the dues.", err)
+		return
+	}
+
+	h.Respond(s, i, statuses.ToDiscordMessage(year))
+}
+
+// handleDuesPaidCommand handles the /dues-paid admin command, which marks (or unmarks) a member's buy-in as paid.
+// Like /commish, it is limited to the commissioner and recorded in the admin audit log.
+func (h *Handler) handleDuesPaidCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	if !h.isCommissioner(i.Member) {
+		h.Respond(s, i, "🚫 Only the commissioner can use this command.")
+		return
+	}
+	actorID := i.Member.User.ID
+
+	targetUser := opts.User(s, "user")
+	amount, _ := opts.Int("amount")
+	paid := opts.Bool("paid", true)
+
+	if targetUser == nil {
+		h.Respond(s, i, "Please choose a user.")
+		return
+	}
+
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	if !paid {
+		if err := h.interactor.MarkDuesUnpaid(ctx, year, targetUser.ID); err != nil {
+			h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't update the dues for %s.", targetUser.Username), err)
+			return
+		}
+		if err := h.interactor.RecordAdminAction(ctx, actorID, domain.AdminActionDuesUnpaid, fmt.Sprintf("%d <@%s>", year, targetUser.ID)); err != nil {
+			h.RespondError(s, i, "The dues were updated, but I couldn't record it in the audit log.", err)
+			return
+		}
+		h.Respond(s, i, fmt.Sprintf("❌ Marked %s's %d dues as unpaid.", targetUser.Mention(), year))
+		return
+	}
+
+	if err := h.interactor.MarkDuesPaid(ctx, year, targetUser.ID, amount, actorID); err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't update the dues for %s.", targetUser.Username), err)
+		return
+	}
+
+	details := fmt.Sprintf("%d <@%s>", year, targetUser.ID)
+	if amount > 0 {
+		details += fmt.Sprintf(" $%d", amount)
+	}
+	if err := h.interactor.RecordAdminAction(ctx, actorID, domain.AdminActionDuesPaid, details); err != nil {
+		h.RespondError(s, i, "The dues were updated, but I couldn't record it in the audit log.", err)
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ Marked %s's %d dues as paid.", targetUser.Mention(), year))
+}
+
+// yearOrLatest returns the "year" option of the command, defaulting to the latest league's year.
+// It responds to the interaction and returns false if the latest league can't be found.
+func (h *Handler) yearOrLatest(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) (int, bool) {
+	if year, ok := opts.Int("year"); ok {
+		return year, true
+	}
+
+	league, err := h.interactor.GetLatestLeague(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get the league.", err)
+		return 0, false
+	}
+	return league.Year, true
+}
@@ -0,0 +1,240 @@
+package discord
+
+import (
+	"context"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Embed colors, matching the green and gold used in the recap emails
+const (
+	embedColorPrimary = 0x0a3d0c
+	embedColorGold    = 0xffd700
+)
+
+// Discord message and embed limits (https://discord.com/developers/docs/resources/message#embed-object-embed-limits)
+const (
+	embedTitleLimit       = 256
+	embedDescriptionLimit = 4096
+	embedFieldLimit       = 25
+	embedFieldNameLimit   = 256
+	embedFieldValueLimit  = 1024
+	embedTotalLimit       = 6000
+	messageEmbedLimit     = 10
+)
+
+// blankFieldName is used for fields that continue the previous field
+const blankFieldName = "\u200b"
+
+const embedFooterText = "Any Given Sunday"
+
+// newEmbed creates an embed with the bot's standard color and footer
+func newEmbed(title string) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title:  truncate(title, embedTitleLimit),
+		Color:  embedColorPrimary,
+		Footer: &discordgo.MessageEmbedFooter{Text: embedFooterText},
+	}
+}
+
+// withThumbnail sets the embed's thumbnail if a URL is available
+func withThumbnail(e *discordgo.MessageEmbed, url string) *discordgo.MessageEmbed {
+	if url != "" {
+		e.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: url}
+	}
+	return e
+}
+
+// avatarURL returns the Sleeper avatar URL of a user, or an empty string if it can't be found.
+// A missing avatar only means the embed has no thumbnail, so errors are logged and not returned.
+func (h *Handler) avatarURL(ctx context.Context, userID string) string {
+	url, err := h.interactor.GetAvatarURL(ctx, userID)
+	if err != nil {
+		log.Printf("error getting avatar for user [%s]: %v", userID, err)
+		return ""
+	}
+	return url
+}
+
+// RespondEmbeds replaces the deferred response with embeds, splitting them to fit Discord's limits.
+// Embeds that don't fit in the first message are sent as follow-ups.
+func (h *Handler) RespondEmbeds(s *discordgo.Session, i *discordgo.InteractionCreate, embeds ...*discordgo.MessageEmbed) {
+	messages := batchEmbeds(splitEmbeds(embeds))
+	if len(messages) == 0 {
+		return
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &messages[0],
+	}); err != nil {
+		log.Printf("error responding to interaction: %s", err.Error())
+		return
+	}
+
+	for _, batch := range messages[1:] {
+		if _, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Embeds: batch}); err != nil {
+			log.Printf("error sending follow-up message: %s", err.Error())
+			return
+		}
+	}
+}
+
+// splitEmbeds splits every embed that exceeds Discord's per-embed limits into continuation embeds
+func splitEmbeds(embeds []*discordgo.MessageEmbed) []*discordgo.MessageEmbed {
+	var split []*discordgo.MessageEmbed
+	for _, e := range embeds {
+		split = append(split, splitEmbed(e)...)
+	}
+	return split
+}
+
+// splitEmbed splits an embed whose description, fields or total length exceed Discord's limits.
+// The first embed keeps the title, author and thumbnail, the last keeps the footer and timestamp,
+// and descriptions and field values are split on line boundaries.
+func splitEmbed(e *discordgo.MessageEmbed) []*discordgo.MessageEmbed {
+	first := &discordgo.MessageEmbed{
+		Title:     e.Title,
+		URL:       e.URL,
+		Color:     e.Color,
+		Author:    e.Author,
+		Thumbnail: e.Thumbnail,
+		Image:     e.Image,
+	}
+	embeds := []*discordgo.MessageEmbed{first}
+	current := first
+
+	// Leave room for the footer, which is added to the last embed
+	footerLength := 0
+	if e.Footer != nil {
+		footerLength = utf8.RuneCountInString(e.Footer.Text)
+	}
+	limit := embedTotalLimit - footerLength
+
+	next := func() {
+		current = &discordgo.MessageEmbed{Color: e.Color}
+		embeds = append(embeds, current)
+	}
+
+	for idx, chunk := range splitLines(e.Description, embedDescriptionLimit) {
+		if idx > 0 || embedLength(current)+utf8.RuneCountInString(chunk) > limit {
+			next()
+		}
+		current.Description = chunk
+	}
+
+	for _, field := range e.Fields {
+		for idx, value := range splitLines(field.Value, embedFieldValueLimit) {
+			name := truncate(field.Name, embedFieldNameLimit)
+			if idx > 0 {
+				name = blankFieldName
+			}
+			f := &discordgo.MessageEmbedField{Name: name, Value: value, Inline: field.Inline}
+
+			if len(current.Fields) >= embedFieldLimit || embedLength(current)+fieldLength(f) > limit {
+				next()
+			}
+			current.Fields = append(current.Fields, f)
+		}
+	}
+
+	current.Footer = e.Footer
+	current.Timestamp = e.Timestamp
+
+	return embeds
+}
+
+// batchEmbeds groups embeds into messages that fit Discord's per-message embed count and length limits
+func batchEmbeds(embeds []*discordgo.MessageEmbed) [][]*discordgo.MessageEmbed {
+	var messages [][]*discordgo.MessageEmbed
+	var batch []*discordgo.MessageEmbed
+	length := 0
+
+	for _, e := range embeds {
+		l := embedLength(e)
+		if len(batch) > 0 && (len(batch) >= messageEmbedLimit || length+l > embedTotalLimit) {
+			messages = append(messages, batch)
+			batch, length = nil, 0
+		}
+		batch = append(batch, e)
+		length += l
+	}
+	if len(batch) > 0 {
+		messages = append(messages, batch)
+	}
+
+	return messages
+}
+
+// embedLength returns the number of characters Discord counts towards an embed's total length
+func embedLength(e *discordgo.MessageEmbed) int {
+	length := utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Description)
+	if e.Author != nil {
+		length += utf8.RuneCountInString(e.Author.Name)
+	}
+	if e.Footer != nil {
+		length += utf8.RuneCountInString(e.Footer.Text)
+	}
+	for _, f := range e.Fields {
+		length += fieldLength(f)
+	}
+	return length
+}
+
+func fieldLength(f *discordgo.MessageEmbedField) int {
+	return utf8.RuneCountInString(f.Name) + utf8.RuneCountInString(f.Value)
+}
+
+// splitLines splits text into chunks of at most limit characters, breaking on newlines where possible
+func splitLines(text string, limit int) []string {
+	if utf8.RuneCountInString(text) <= limit {
+		if text == "" {
+			return nil
+		}
+		return []string{text}
+	}
+
+	var chunks []string
+	var chunk strings.Builder
+	chunkLength := 0
+
+	flush := func() {
+		if chunkLength > 0 {
+			chunks = append(chunks, strings.TrimRight(chunk.String(), "\n"))
+			chunk.Reset()
+			chunkLength = 0
+		}
+	}
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		lineLength := utf8.RuneCountInString(line)
+		if chunkLength+lineLength > limit {
+			flush()
+		}
+
+		// A single line longer than the limit is hard-split
+		for lineLength > limit {
+			runes := []rune(line)
+			chunks = append(chunks, string(runes[:limit]))
+			line = string(runes[limit:])
+			lineLength -= limit
+		}
+
+		chunk.WriteString(line)
+		chunkLength += lineLength
+	}
+	flush()
+
+	return chunks
+}
+
+// truncate shortens text to at most limit characters
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,133 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		limit    int
+		expected []string
+	}{
+		{name: "empty", text: "", limit: 10, expected: nil},
+		{name: "fits", text: "one\ntwo", limit: 10, expected: []string{"one\ntwo"}},
+		{name: "splits on newlines", text: "aaaa\nbbbb\ncccc\n", limit: 10, expected: []string{"aaaa\nbbbb", "cccc"}},
+		{name: "hard splits long lines", text: "aaaaaaaaaaaa", limit: 5, expected: []string{"aaaaa", "aaaaa", "aa"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, splitLines(tt.text, tt.limit))
+		})
+	}
+}
+
+func TestSplitEmbed(t *testing.T) {
+	t.Run("small embed is unchanged", func(t *testing.T) {
+		e := newEmbed("Title")
+		e.Fields = []*discordgo.MessageEmbedField{{Name: "a", Value: "b"}}
+
+		split := splitEmbed(e)
+
+		require.Len(t, split, 1)
+		assert.Equal(t, "Title", split[0].Title)
+		assert.Equal(t, e.Fields, split[0].Fields)
+		assert.Equal(t, embedFooterText, split[0].Footer.Text)
+	})
+
+	t.Run("too many fields continue in a new embed", func(t *testing.T) {
+		e := withThumbnail(newEmbed("Standings"), "https://example.com/avatar.png")
+		for i := 0; i < 30; i++ {
+			e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: fmt.Sprintf("Team %d", i), Value: "10-3"})
+		}
+
+		split := splitEmbed(e)
+
+		require.Len(t, split, 2)
+		assert.Len(t, split[0].Fields, embedFieldLimit)
+		assert.Len(t, split[1].Fields, 5)
+		assert.Equal(t, "Standings", split[0].Title)
+		assert.NotNil(t, split[0].Thumbnail)
+		assert.Nil(t, split[0].Footer, "footer only goes on the last embed")
+		assert.Empty(t, split[1].Title)
+		assert.Equal(t, embedFooterText, split[1].Footer.Text)
+		assert.Equal(t, embedColorPrimary, split[1].Color)
+	})
+
+	t.Run("long field values are split on lines", func(t *testing.T) {
+		line := strings.Repeat("x", 99) + "\n"
+		e := newEmbed("Summary")
+		e.Fields = []*discordgo.MessageEmbedField{{Name: "Standings", Value: strings.Repeat(line, 15)}}
+
+		split := splitEmbed(e)
+
+		require.Len(t, split, 1)
+		require.Len(t, split[0].Fields, 2)
+		assert.Equal(t, "Standings", split[0].Fields[0].Name)
+		assert.Equal(t, blankFieldName, split[0].Fields[1].Name)
+		for _, f := range split[0].Fields {
+			assert.LessOrEqual(t, len(f.Value), embedFieldValueLimit)
+		}
+	})
+
+	t.Run("embeds never exceed the total length limit", func(t *testing.T) {
+		e := newEmbed("Big")
+		for i := 0; i < 20; i++ {
+			e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: "field", Value: strings.Repeat("y", 1000)})
+		}
+
+		split := splitEmbed(e)
+
+		assert.Greater(t, len(split), 1)
+		for _, s := range split {
+			assert.LessOrEqual(t, embedLength(s), embedTotalLimit)
+		}
+	})
+}
+
+func TestBatchEmbeds(t *testing.T) {
+	var embeds []*discordgo.MessageEmbed
+	for i := 0; i < 12; i++ {
+		embeds = append(embeds, &discordgo.MessageEmbed{Description: "short"})
+	}
+
+	batches := batchEmbeds(embeds)
+	require.Len(t, batches, 2)
+	assert.Len(t, batches[0], messageEmbedLimit)
+	assert.Len(t, batches[1], 2)
+
+	large := []*discordgo.MessageEmbed{
+		{Description: strings.Repeat("a", 4000)},
+		{Description: strings.Repeat("b", 4000)},
+	}
+	assert.Len(t, batchEmbeds(large), 2, "two 4000 character embeds don't fit in one message")
+	assert.Empty(t, batchEmbeds(nil))
+}
+
+func TestStandingsEmbed(t *testing.T) {
+	var standings domain.Standings
+	users := domain.UserMap{}
+	for i := 0; i < 8; i++ {
+		userID := fmt.Sprintf("user%d", i)
+		standings = append(standings, &domain.Standing{UserID: userID, Wins: 8 - i, Losses: i})
+		users[userID] = domain.User{ID: userID, Name: fmt.Sprintf("Team %d", i)}
+	}
+
+	e := standingsEmbed(domain.League{Year: 2024, Status: domain.LeagueStatusInProgress}, standings, users, "", standingsViewFinal)
+
+	assert.Equal(t, "🏆 2024 Standings 🏆", e.Title)
+	assert.Nil(t, e.Thumbnail)
+	require.Len(t, e.Fields, 9, "8 teams plus the playoff line")
+	assert.Equal(t, "🥇 Team 0", e.Fields[0].Name)
+	assert.Equal(t, "7. Team 6", e.Fields[7].Name)
+	assert.Contains(t, e.Fields[6].Value, "Playoffs")
+}
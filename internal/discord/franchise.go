@@ -0,0 +1,100 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+// franchiseOption picks a franchise by team name, autocompleting from every franchise in the league
+func franchiseOption(description string, required bool) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:         discordgo.ApplicationCommandOptionString,
+		Name:         "franchise",
+		Description:  description,
+		Required:     required,
+		Autocomplete: true,
+	}
+}
+
+func (h *Handler) franchiseHistoryCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameFranchise,
+			Description: "Show everyone who has owned a team",
+			Options: []*discordgo.ApplicationCommandOption{
+				franchiseOption("The team to show the history of", true),
+			},
+		},
+		handle: h.handleFranchiseHistoryCommand,
+	}
+}
+
+// handleFranchiseHistoryCommand handles the /franchise-history Discord command, listing a franchise's owners by season
+func (h *Handler) handleFranchiseHistoryCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	franchise, ok := h.franchise(ctx, s, i, opts.String("franchise"))
+	if !ok {
+		return
+	}
+
+	history, err := h.interactor.GetFranchiseHistory(ctx, franchise.ID)
+	if err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't get the history of %s.", franchise.DisplayName()), err)
+		return
+	}
+
+	users, err := h.interactor.GetUsers(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get users.", err)
+		return
+	}
+
+	h.RespondEmbeds(s, i, franchiseHistoryEmbed(history, users, h.avatarURL(ctx, franchise.OwnerID)))
+}
+
+// franchise returns the franchise a "franchise" option refers to.
+// It responds to the interaction and returns false if the franchise can't be found.
+func (h *Handler) franchise(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, value string) (domain.Franchise, bool) {
+	franchises, err := h.interactor.GetFranchises(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get franchises.", err)
+		return domain.Franchise{}, false
+	}
+
+	franchise, ok := resolveFranchise(franchises, value)
+	if !ok {
+		h.Respond(s, i, fmt.Sprintf("Hmm... I couldn't find a team named %s.", value))
+		return domain.Franchise{}, false
+	}
+	return franchise, true
+}
+
+// franchiseHistoryEmbed renders a franchise's owners as an embed with one field per tenure, oldest first
+func franchiseHistoryEmbed(history domain.FranchiseHistory, users domain.UserMap, avatarURL string) *discordgo.MessageEmbed {
+	e := withThumbnail(newEmbed(fmt.Sprintf("%s Franchise History 📜", history.Franchise.DisplayName())), avatarURL)
+
+	if len(history.Franchise.Managers) > 0 {
+		e.Description = fmt.Sprintf("Currently managed by %s", history.Franchise.ManagerNames())
+	}
+
+	tenures := history.Tenures()
+	if len(tenures) == 0 {
+		e.Description += "\n\nNo seasons have been recorded for this team yet. The commissioner can record them with `/commish sync-franchises`."
+		return e
+	}
+
+	for _, tenure := range tenures {
+		owner := tenure.UserID
+		if user, ok := users[tenure.UserID]; ok {
+			owner = user.Name
+		}
+		value := owner
+		if tenure.TeamName != "" {
+			value = fmt.Sprintf("%s as **%s**", owner, tenure.TeamName)
+		}
+		e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: tenure.Seasons(), Value: value})
+	}
+	return e
+}
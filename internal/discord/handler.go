@@ -3,6 +3,7 @@ package discord
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 	"github.com/sam-maryland/any-given-sunday/internal/interactor"
@@ -15,18 +16,44 @@ import (
 type Handler struct {
 	session    *discordgo.Session
 	interactor interactor.Interactor
+	commands   map[string]command
+
+	welcomeMessages *WelcomeMessages
+
+	guildID              string
+	welcomeChannelID     string
+	commissionerRoleID   string
+	weeklyRecapChannelID string
+	managerRoleID        string
+	setNicknames         bool
 }
 
 // NewHandler creates a new Handler
 func NewHandler(cfg *config.Config, chain *dependency.Chain, interactor interactor.Interactor) *Handler {
-	registerCommands(cfg, chain)
 	h := &Handler{
-		session:    chain.Discord,
-		interactor: interactor,
+		session:              chain.Discord,
+		interactor:           interactor,
+		guildID:              cfg.GuildID,
+		welcomeChannelID:     cfg.WelcomeChannelID,
+		commissionerRoleID:   cfg.CommissionerRoleID,
+		weeklyRecapChannelID: cfg.WeeklyRecapChannelID,
+		managerRoleID:        cfg.ManagerRoleID,
+		setNicknames:         cfg.SetNicknames,
+	}
+
+	h.welcomeMessages = NewWelcomeMessages(dependency.NewDiscordWrapper(chain.Discord), interactor, cfg.GuildID, cfg.WelcomeChannelID)
+
+	commands := h.commandRegistry()
+	h.commands = commandsByName(commands)
+	if err := registerCommands(dependency.NewDiscordWrapper(chain.Discord), cfg.AppID, cfg.GuildID, commands); err != nil {
+		// Discord keeps the previously registered commands, so the bot can still serve them
+		log.Printf("⚠️ %v", err)
 	}
+
 	chain.Discord.AddHandler(h.Handle)
 	chain.Discord.AddHandler(h.OnGuildMemberAdd)
 	chain.Discord.AddHandler(h.HandleComponentInteraction)
+	chain.Discord.AddHandler(h.HandleAutocomplete)
 	return h
 }
 
@@ -36,76 +63,52 @@ func (h *Handler) Handle(s *discordgo.Session, i *discordgo.InteractionCreate) {
 		return
 	}
 
-	ctx := context.Background()
+	data := i.ApplicationCommandData()
+	log.Printf("received command: %s", data.Name)
 
-	log.Printf("received command: %s", i.ApplicationCommandData().Name)
+	c, ok := h.commands[data.Name]
 
-	switch i.ApplicationCommandData().Name {
-	case commandNameCareerStats:
-		h.handleCareerStatsCommand(ctx, s, i)
-	case commandNameStandings:
-		h.handleStandingsCommand(ctx, s, i)
-	case commandNameWeeklySummary:
-		h.handleWeeklySummaryCommand(ctx, s, i)
-	default:
-		log.Printf("unknown command name: %s", i.ApplicationCommandData().Name)
+	// Acknowledge right away, then edit the response once the work is done
+	if err := h.deferResponse(s, i, c.ephemeral); err != nil {
+		log.Printf("error deferring response to command %s: %v", data.Name, err)
+		return
 	}
 
-	log.Printf("handled command: %s", i.ApplicationCommandData().Name)
+	if !ok {
+		log.Printf("unknown command name: %s", data.Name)
+		h.Respond(s, i, "Hmm... I don't know that command.")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	c.handle(ctx, s, i, newCommandOptions(data.Options))
+
+	log.Printf("handled command: %s", data.Name)
 }
 
+// commandTimeout bounds the DB and Sleeper work done for a single command
+const commandTimeout = 30 * time.Second
+
 const (
 	commandNameCareerStats   = "career-stats"
 	commandNameStandings     = "standings"
 	commandNameWeeklySummary = "weekly-summary"
+	commandNameLedger        = "ledger"
+	commandNameDues          = "dues"
+	commandNameDuesPaid      = "dues-paid"
+	commandNameCommish       = "commish"
+	commandNameLink          = "link"
+	commandNameUnlink        = "unlink"
+	commandNameWhoami        = "whoami"
+	commandNameEmail         = "email"
+	commandNameFranchise     = "franchise-history"
+	commandNameTrades        = "trades"
+	commandNameDraft         = "draft"
+	commandNameKeepers       = "keepers"
+	commandNameWaivers       = "waivers"
 )
 
-// registerCommands registers Discord bot commands that are accessible with slash commands (i.e. "/standings 2024")
-func registerCommands(cfg *config.Config, c *dependency.Chain) {
-	commands := []*discordgo.ApplicationCommand{
-		{
-			Name:        commandNameCareerStats,
-			Description: "Get career stats for a specific user",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionUser,
-					Name:        "user",
-					Description: "The user to get stats for",
-					Required:    true,
-				},
-			},
-		},
-		{
-			Name:        commandNameStandings,
-			Description: "Get the standings for a specific year",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionNumber,
-					Name:        "year",
-					Description: "The year to get standings for",
-					Required:    false,
-				},
-			},
-		},
-		{
-			Name:        commandNameWeeklySummary,
-			Description: "Generate weekly summary with high score winner and updated standings",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionNumber,
-					Name:        "year",
-					Description: "The year to generate summary for",
-					Required:    false,
-				},
-			},
-		},
-	}
-
-	for _, command := range commands {
-		log.Printf("registering command: %s", command.Name)
-		_, err := c.Discord.ApplicationCommandCreate(cfg.AppID, cfg.GuildID, command)
-		if err != nil {
-			log.Fatalf("cannot create command %s: %v", command.Name, err)
-		}
-	}
-}
+// adminPermissions restricts a command to members who can manage the server (i.e. the commissioner)
+var adminPermissions int64 = discordgo.PermissionManageGuild
@@ -2,6 +2,7 @@ package discord
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
@@ -32,6 +33,12 @@ func (m *mockInteractor) GetStandingsForLeague(ctx context.Context, league domai
 	}
 	return domain.Standings{}, nil
 }
+func (m *mockInteractor) GetLeagueYears(ctx context.Context) ([]int, error) {
+	return nil, nil
+}
+func (m *mockInteractor) GetRegularSeasonStandingsForLeague(ctx context.Context, league domain.League) (domain.Standings, error) {
+	return domain.Standings{}, nil
+}
 
 // StatsInteractor methods
 func (m *mockInteractor) GetCareerStatsForDiscordUser(ctx context.Context, userID string) (domain.CareerStats, error) {
@@ -40,11 +47,23 @@ func (m *mockInteractor) GetCareerStatsForDiscordUser(ctx context.Context, userI
 	}
 	return domain.CareerStats{}, nil
 }
+func (m *mockInteractor) GetCareerStatsForUser(ctx context.Context, userID string) (domain.CareerStats, error) {
+	return domain.CareerStats{}, nil
+}
+func (m *mockInteractor) GetCareerStatsForFranchise(ctx context.Context, franchiseID int) (domain.CareerStats, error) {
+	return domain.CareerStats{}, nil
+}
 
 // UsersInteractor methods
 func (m *mockInteractor) GetUsers(ctx context.Context) (domain.UserMap, error) {
 	return domain.UserMap{}, nil
 }
+func (m *mockInteractor) GetAvatarURL(ctx context.Context, userID string) (string, error) {
+	return "", nil
+}
+func (m *mockInteractor) GetManagerNames(ctx context.Context) (domain.UserMap, error) {
+	return domain.UserMap{}, nil
+}
 
 // WeeklyJobInteractor methods
 func (m *mockInteractor) SyncLatestData(ctx context.Context, year int) error { return nil }
@@ -57,6 +76,9 @@ func (m *mockInteractor) GenerateWeeklySummary(ctx context.Context, year int) (*
 	}
 	return &interactor.WeeklySummary{}, nil
 }
+func (m *mockInteractor) GenerateWeeklySummaryForWeek(ctx context.Context, year, week int) (*interactor.WeeklySummary, error) {
+	return m.GenerateWeeklySummary(ctx, year)
+}
 
 // OnboardingInteractor methods
 func (m *mockInteractor) GetAvailableSleeperUsers(ctx context.Context) ([]interactor.AvailableSleeperUser, error) {
@@ -68,6 +90,157 @@ func (m *mockInteractor) LinkDiscordToSleeperUser(ctx context.Context, discordID
 func (m *mockInteractor) IsUserOnboarded(ctx context.Context, discordID string) (bool, error) {
 	return false, nil
 }
+func (m *mockInteractor) GetLinkedUser(ctx context.Context, discordID string) (domain.User, error) {
+	return domain.User{}, interactor.ErrNotLinked
+}
+func (m *mockInteractor) UnlinkDiscordUser(ctx context.Context, discordID string) (domain.User, error) {
+	return domain.User{}, nil
+}
+func (m *mockInteractor) SetEmailForDiscordUser(ctx context.Context, discordID, email string) (domain.User, error) {
+	return domain.User{}, nil
+}
+func (m *mockInteractor) CompleteOnboarding(ctx context.Context, discordID string) error {
+	return nil
+}
+func (m *mockInteractor) GetTeamName(ctx context.Context, userID string) (string, error) {
+	return "", nil
+}
+func (m *mockInteractor) RecordWelcomeMessage(ctx context.Context, discordID, channelID, messageID string) error {
+	return nil
+}
+func (m *mockInteractor) GetWelcomeMessages(ctx context.Context) (domain.WelcomeMessages, error) {
+	return nil, nil
+}
+func (m *mockInteractor) RemoveWelcomeMessage(ctx context.Context, discordID string) (*domain.WelcomeMessage, error) {
+	return nil, nil
+}
+func (m *mockInteractor) RecordOnboardingReminder(ctx context.Context, discordID string) error {
+	return nil
+}
+
+// LedgerInteractor methods
+func (m *mockInteractor) GetPayoutRules(ctx context.Context, year int) (domain.PayoutRules, error) {
+	return domain.DefaultPayoutRules(year), nil
+}
+func (m *mockInteractor) GetLedgerForDiscordUser(ctx context.Context, discordID string, year int) (domain.Ledger, error) {
+	return domain.Ledger{}, nil
+}
+func (m *mockInteractor) GetLedgerBalances(ctx context.Context, year int) (domain.LedgerBalances, error) {
+	return domain.LedgerBalances{}, nil
+}
+func (m *mockInteractor) SettleSeasonLedger(ctx context.Context, year int) error { return nil }
+func (m *mockInteractor) SettleUnsettledSeasons(ctx context.Context) ([]int, error) {
+	return nil, nil
+}
+
+// DuesInteractor methods
+func (m *mockInteractor) RecordSeasonBuyIns(ctx context.Context, year int) error { return nil }
+func (m *mockInteractor) GetDuesStatus(ctx context.Context, year int) (domain.DuesStatuses, error) {
+	return domain.DuesStatuses{}, nil
+}
+func (m *mockInteractor) MarkDuesPaid(ctx context.Context, year int, discordID string, amount int, recordedBy string) error {
+	return nil
+}
+func (m *mockInteractor) MarkDuesUnpaid(ctx context.Context, year int, discordID string) error {
+	return nil
+}
+func (m *mockInteractor) IsBeforeTradeDeadline(ctx context.Context, year int) (bool, error) {
+	return false, nil
+}
+
+// SeasonInteractor methods
+func (m *mockInteractor) CompleteSeasonIfFinished(ctx context.Context, year int) (bool, error) {
+	return false, nil
+}
+func (m *mockInteractor) GetSeasonAwards(ctx context.Context, year int) (*domain.SeasonAwards, error) {
+	return &domain.SeasonAwards{}, nil
+}
+
+// YearInReviewInteractor methods
+func (m *mockInteractor) GetYearInReviews(ctx context.Context, year int) (domain.YearInReviews, error) {
+	return domain.YearInReviews{}, nil
+}
+
+// AdminInteractor methods
+func (m *mockInteractor) ForceSync(ctx context.Context, actorID string, year int) error  { return nil }
+func (m *mockInteractor) SyncFranchiseHistory(ctx context.Context, actorID string) error { return nil }
+func (m *mockInteractor) SettleLedgerHistory(ctx context.Context, actorID string) error  { return nil }
+func (m *mockInteractor) SetPayoutRules(ctx context.Context, actorID string, rules domain.PayoutRules) error {
+	return nil
+}
+func (m *mockInteractor) AdjustLedger(ctx context.Context, actorID string, adjustment interactor.LedgerAdjustment) error {
+	return nil
+}
+func (m *mockInteractor) SetUserEmail(ctx context.Context, actorID, userID, email string) error {
+	return nil
+}
+func (m *mockInteractor) LinkUser(ctx context.Context, actorID, userID, discordID string) error {
+	return nil
+}
+func (m *mockInteractor) UnlinkUser(ctx context.Context, actorID, userID string) error { return nil }
+func (m *mockInteractor) SetLeagueStatus(ctx context.Context, actorID string, year int, status string) error {
+	return nil
+}
+func (m *mockInteractor) CorrectMatchup(ctx context.Context, actorID string, correction interactor.MatchupCorrection) error {
+	return nil
+}
+func (m *mockInteractor) RecordAdminAction(ctx context.Context, actorID, action, details string) error {
+	return nil
+}
+func (m *mockInteractor) GetAdminAuditLog(ctx context.Context, limit int) (domain.AdminAuditLog, error) {
+	return domain.AdminAuditLog{}, nil
+}
+
+// FranchiseInteractor methods
+func (m *mockInteractor) SyncFranchises(ctx context.Context, year int) error { return nil }
+func (m *mockInteractor) GetFranchises(ctx context.Context) (domain.Franchises, error) {
+	return domain.Franchises{}, nil
+}
+func (m *mockInteractor) GetFranchiseHistory(ctx context.Context, franchiseID int) (domain.FranchiseHistory, error) {
+	return domain.FranchiseHistory{}, nil
+}
+
+// TransactionInteractor methods
+func (m *mockInteractor) SyncTransactions(ctx context.Context, year int) error { return nil }
+func (m *mockInteractor) GetTransactions(ctx context.Context, year int) (domain.Transactions, error) {
+	return domain.Transactions{}, nil
+}
+func (m *mockInteractor) GetUnpostedTransactions(ctx context.Context, year int) (domain.Transactions, error) {
+	return domain.Transactions{}, nil
+}
+func (m *mockInteractor) HasPostedTransactions(ctx context.Context, year int) (bool, error) {
+	return false, nil
+}
+func (m *mockInteractor) MarkTransactionPosted(ctx context.Context, transactionID string) error {
+	return nil
+}
+func (m *mockInteractor) GetTradeGrades(ctx context.Context, year int) (domain.TradeGrades, error) {
+	return domain.TradeGrades{}, nil
+}
+func (m *mockInteractor) GetTradeGradesForDiscordUser(ctx context.Context, discordID string, year int) (domain.TradeGrades, error) {
+	return domain.TradeGrades{}, nil
+}
+func (m *mockInteractor) SyncDraft(ctx context.Context, year int) error {
+	return nil
+}
+func (m *mockInteractor) GetDraftReport(ctx context.Context, year int) (domain.DraftReport, error) {
+	return domain.DraftReport{}, nil
+}
+func (m *mockInteractor) GetDraftReportForDiscordUser(ctx context.Context, discordID string, year int) (domain.DraftReport, error) {
+	return domain.DraftReport{}, nil
+}
+func (m *mockInteractor) SyncTradedPicks(ctx context.Context, year int) error {
+	return nil
+}
+func (m *mockInteractor) GetKeeperReport(ctx context.Context) (domain.KeeperReport, error) {
+	return domain.KeeperReport{}, nil
+}
+func (m *mockInteractor) GetKeeperReportForDiscordUser(ctx context.Context, discordID string) (domain.KeeperReport, error) {
+	return domain.KeeperReport{}, nil
+}
+func (m *mockInteractor) GetWaiverReport(ctx context.Context, year int) (domain.WaiverReport, error) {
+	return domain.WaiverReport{}, nil
+}
 
 // testableHandler allows us to test with mock dependencies
 type testableHandler struct {
@@ -356,3 +529,8 @@ func TestHandler_Handle_ErrorHandling(t *testing.T) {
 		handler.Handle(nil, interaction)
 	})
 }
+
+func TestErrorMessage(t *testing.T) {
+	assert.Equal(t, "Hmm... I couldn't get the standings.", errorMessage("Hmm... I couldn't get the standings.", assert.AnError))
+	assert.Equal(t, "Hmm... that took too long. Please try again in a bit.", errorMessage("Hmm... I couldn't get the standings.", fmt.Errorf("failed to get standings: %w", context.DeadlineExceeded)))
+}
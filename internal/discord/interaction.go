@@ -1,18 +1,63 @@
 package discord
 
 import (
+	"context"
+	"errors"
 	"log"
 
 	"github.com/bwmarrin/discordgo"
 )
 
+// messageContentLimit is the maximum length of a Discord message's content
+const messageContentLimit = 2000
+
+// deferResponse acknowledges a command immediately so slow work doesn't miss Discord's 3 second deadline.
+// Discord shows a "thinking..." message until the response is edited by Respond, RespondEmbeds or RespondError.
+// Ephemeral responses, and any follow-ups to them, are only visible to the member who ran the command.
+func (h *Handler) deferResponse(s *discordgo.Session, i *discordgo.InteractionCreate, ephemeral bool) error {
+	var data *discordgo.InteractionResponseData
+	if ephemeral {
+		data = &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}
+	}
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: data,
+	})
+}
+
+// Respond replaces the deferred response with content, sending any content past Discord's message limit as follow-ups.
 func (h *Handler) Respond(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
-	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Content: content,
-		},
+	chunks := splitLines(content, messageContentLimit)
+	if len(chunks) == 0 {
+		return
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: &chunks[0],
 	}); err != nil {
 		log.Printf("error responding to interaction: %s", err.Error())
+		return
+	}
+
+	for _, chunk := range chunks[1:] {
+		if _, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: chunk}); err != nil {
+			log.Printf("error sending follow-up message: %s", err.Error())
+			return
+		}
+	}
+}
+
+// RespondError logs why a command failed and tells the user what went wrong.
+// Commands that ran out of time get a generic timeout message instead.
+func (h *Handler) RespondError(s *discordgo.Session, i *discordgo.InteractionCreate, message string, err error) {
+	log.Printf("error handling command %s: %v", i.ApplicationCommandData().Name, err)
+	h.Respond(s, i, errorMessage(message, err))
+}
+
+// errorMessage returns the message to show the user for err.
+func errorMessage(message string, err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "Hmm... that took too long. Please try again in a bit."
 	}
+	return message
 }
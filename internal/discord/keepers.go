@@ -0,0 +1,129 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+func (h *Handler) keepersCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameKeepers,
+			Description: "Show each team's eligible keepers and the upcoming draft picks it owns",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "Only show this user's team",
+					Required:    false,
+				},
+			},
+		},
+		handle: h.handleKeepersCommand,
+	}
+}
+
+// handleKeepersCommand handles the /keepers Discord command, listing what each rostered player would cost to keep
+func (h *Handler) handleKeepersCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	targetUser := opts.User(s, "user")
+
+	var report domain.KeeperReport
+	var err error
+	if targetUser == nil {
+		report, err = h.interactor.GetKeeperReport(ctx)
+	} else {
+		report, err = h.interactor.GetKeeperReportForDiscordUser(ctx, targetUser.ID)
+	}
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get keepers.", err)
+		return
+	}
+
+	h.RespondEmbeds(s, i, keepersEmbed(report))
+}
+
+// keepersEmbed renders a keeper report as an embed with the league's rules up top and one field per franchise
+func keepersEmbed(report domain.KeeperReport) *discordgo.MessageEmbed {
+	e := newEmbed(fmt.Sprintf("%d Keepers 🔒", report.Year))
+	e.Description = keeperRulesDescription(report.Rules)
+
+	if len(report.Franchises) == 0 {
+		e.Description += "\n\nNo teams found."
+		return e
+	}
+
+	for _, fk := range report.Franchises {
+		var b strings.Builder
+		var ineligible []string
+		for _, k := range fk.Keepers {
+			switch {
+			case k.Taxi:
+				fmt.Fprintf(&b, "• %s: taxi squad\n", k.Player.Description())
+			case !k.Eligible:
+				ineligible = append(ineligible, k.Player.Name)
+			case k.YearsKept > 0:
+				fmt.Fprintf(&b, "• %s: Round %d (kept %d)\n", k.Player.Description(), k.Round, k.YearsKept)
+			default:
+				fmt.Fprintf(&b, "• %s: Round %d\n", k.Player.Description(), k.Round)
+			}
+		}
+		if len(ineligible) > 0 {
+			fmt.Fprintf(&b, "🚫 Can't be kept again: %s\n", strings.Join(ineligible, ", "))
+		}
+		if picks := futurePicksDescription(fk.Picks); picks != "" {
+			fmt.Fprintf(&b, "🎟️ **Picks**\n%s", picks)
+		}
+		if b.Len() == 0 {
+			b.WriteString("Nobody on the roster yet.")
+		}
+
+		e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: fk.Franchise.DisplayName(), Value: b.String()})
+	}
+	return e
+}
+
+// keeperRulesDescription explains how keepers are priced
+func keeperRulesDescription(rules domain.KeeperRules) string {
+	if rules.MaxKeepers == 0 {
+		return "This league isn't set up for keepers in Sleeper, so these are the prices if it were."
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Each team can keep up to %d players. ", rules.MaxKeepers)
+	if rules.RoundPenalty > 0 {
+		fmt.Fprintf(&b, "A kept player costs a pick %d round(s) earlier than the round they were last drafted in, and undrafted players cost the last round. ", rules.RoundPenalty)
+	} else {
+		b.WriteString("A kept player costs the round they were last drafted in, and undrafted players cost the last round. ")
+	}
+	if rules.MaxYears > 0 {
+		fmt.Fprintf(&b, "Players can be kept %d seasons in a row.", rules.MaxYears)
+	}
+	if rules.TaxiSlots > 0 {
+		fmt.Fprintf(&b, "\nUp to %d taxi squad players stay without using a keeper spot.", rules.TaxiSlots)
+	}
+	return b.String()
+}
+
+// futurePicksDescription lists a franchise's picks with one line per season (e.g. "2026: 1, 2, 2 (from Team 4), 3")
+func futurePicksDescription(picks []domain.FuturePick) string {
+	var b strings.Builder
+	for idx, p := range picks {
+		if idx == 0 || picks[idx-1].Season != p.Season {
+			if idx > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "%d: ", p.Season)
+		} else {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%d", p.Round)
+		if p.From != "" {
+			fmt.Fprintf(&b, " (from %s)", p.From)
+		}
+	}
+	return b.String()
+}
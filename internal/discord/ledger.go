@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (h *Handler) ledgerCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameLedger,
+			Description: "Get buy-ins, payouts and balances for a specific year",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The user to get the ledger for (defaults to the whole league)",
+					Required:    false,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year to get the ledger for",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		handle: h.handleLedgerCommand,
+	}
+}
+
+// handleLedgerCommand handles the /ledger Discord command.
+// With a user it shows that user's itemized entries, otherwise it shows every member's balance.
+func (h *Handler) handleLedgerCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	targetUser := opts.User(s, "user")
+
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	if targetUser == nil {
+		balances, err := h.interactor.GetLedgerBalances(ctx, year)
+		if err != nil {
+			h.RespondError(s, i, "Hmm... I couldn't get the ledger.", err)
+			return
+		}
+		h.Respond(s, i, balances.ToDiscordMessage(year))
+		return
+	}
+
+	ledger, err := h.interactor.GetLedgerForDiscordUser(ctx, targetUser.ID, year)
+	if err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't find a ledger for %s.", targetUser.Username), err)
+		return
+	}
+	h.Respond(s, i, ledger.ToDiscordMessage())
+}
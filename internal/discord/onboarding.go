@@ -4,17 +4,30 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 	"github.com/sam-maryland/any-given-sunday/internal/interactor"
-	"github.com/sam-maryland/any-given-sunday/pkg/config"
 
 	"github.com/bwmarrin/discordgo"
 )
 
+// Welcome message components encode the member they were sent to, so nobody else can use them
+// (e.g. "sleeper_user_select:<discord ID>" or "sleeper_user_page:<discord ID>:<page>")
 const (
 	componentIDSleeperUserSelect = "sleeper_user_select"
+	componentIDSleeperUserPage   = "sleeper_user_page"
+	// Retry buttons encode the Discord user to finish onboarding (e.g. "onboarding_retry:<discord ID>")
+	componentIDOnboardingRetry = "onboarding_retry"
 )
 
+// nicknameLimit is the maximum length of a Discord nickname
+const nicknameLimit = 32
+
+// selectMenuOptionLimit is the maximum number of options in a Discord select menu
+const selectMenuOptionLimit = 25
+
 // OnGuildMemberAdd handles when new users join the Discord server
 func (h *Handler) OnGuildMemberAdd(s *discordgo.Session, m *discordgo.GuildMemberAdd) {
 	// Skip if user is a bot
@@ -36,64 +49,64 @@ func (h *Handler) OnGuildMemberAdd(s *discordgo.Session, m *discordgo.GuildMembe
 		return
 	}
 
+	// Members who linked their account but were never set up in Discord (e.g. they left and rejoined) just need finishing
+	if _, err := h.interactor.GetLinkedUser(ctx, m.User.ID); err == nil {
+		if err := h.completeOnboarding(ctx, dependency.NewDiscordWrapper(s), m.User.ID); err != nil {
+			log.Printf("Error completing onboarding for user %s: %v", m.User.ID, err)
+		}
+		return
+	}
+
 	// Send welcome message
-	err = h.sendWelcomeMessage(ctx, s, m.User)
+	err = h.welcomeMessages.Send(ctx, m.User)
 	if err != nil {
 		log.Printf("Error sending welcome message to user %s: %v", m.User.ID, err)
 	}
 }
 
-// sendWelcomeMessage creates and sends the onboarding welcome message with Sleeper user selection
-func (h *Handler) sendWelcomeMessage(ctx context.Context, s *discordgo.Session, user *discordgo.User) error {
-	// Get available Sleeper users
-	availableUsers, err := h.interactor.GetAvailableSleeperUsers(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get available Sleeper users: %w", err)
-	}
-
+// welcomeMessage returns the content and components of a member's welcome message, offering one page of the
+// Sleeper accounts that are still available. Out of range pages are clamped to the first or last page.
+func welcomeMessage(discordID string, availableUsers []interactor.AvailableSleeperUser, page int) (string, []discordgo.MessageComponent) {
 	if len(availableUsers) == 0 {
-		// No available users - send message indicating all accounts are claimed
-		return h.sendNoAvailableUsersMessage(s, user)
+		return noAvailableUsersContent(discordID), []discordgo.MessageComponent{}
 	}
 
-	// Create select menu with available Sleeper users
-	selectMenu := h.createSleeperUserSelectMenu(availableUsers)
+	pages := (len(availableUsers) + selectMenuOptionLimit - 1) / selectMenuOptionLimit
+	page = max(0, min(page, pages-1))
+	start := page * selectMenuOptionLimit
+	end := min(start+selectMenuOptionLimit, len(availableUsers))
 
-	// Create welcome message content
 	content := fmt.Sprintf(
 		"<@%s> **Welcome to the Any Given Sunday Discord!** 🏈\n\n"+
 			"To get started and use the bot commands, please select your Sleeper account from the dropdown below. "+
-			"This links your Discord account to your fantasy team.\n\n"+
-			"**Choose your Sleeper account:**",
-		user.ID,
+			"This links your Discord account to your fantasy team.\n\n",
+		discordID,
 	)
+	if pages > 1 {
+		content += "Can't see your account? Use the buttons to see more, or search for it with `/link` in the server.\n\n"
+	}
+	content += "**Choose your Sleeper account:**"
 
-	// Get welcome channel ID from config
-	cfg := config.InitConfig() // You might want to pass this through dependency injection
-	welcomeChannelID := cfg.WelcomeChannelID
-
-	// Send message to welcome channel
-	_, err = s.ChannelMessageSendComplex(welcomeChannelID, &discordgo.MessageSend{
-		Content: content,
-		Components: []discordgo.MessageComponent{
-			discordgo.ActionsRow{
-				Components: []discordgo.MessageComponent{selectMenu},
-			},
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{createSleeperUserSelectMenu(discordID, availableUsers[start:end])},
 		},
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to send welcome message: %w", err)
+	}
+	if pages > 1 {
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				pageButton(componentIDSleeperUserPage, "prev", "◀ Previous", sleeperUserPageCustomID(discordID, page-1), page == 0),
+				pageButton(componentIDSleeperUserPage, "page", fmt.Sprintf("Page %d of %d", page+1, pages), "", true),
+				pageButton(componentIDSleeperUserPage, "next", "Next ▶", sleeperUserPageCustomID(discordID, page+1), page == pages-1),
+			},
+		})
 	}
 
-	log.Printf("Sent welcome message to user %s (%s) with %d available Sleeper accounts",
-		user.Username, user.ID, len(availableUsers))
-
-	return nil
+	return content, components
 }
 
-// createSleeperUserSelectMenu creates a Discord select menu with available Sleeper users
-func (h *Handler) createSleeperUserSelectMenu(users []interactor.AvailableSleeperUser) *discordgo.SelectMenu {
+// createSleeperUserSelectMenu creates a Discord select menu with available Sleeper users for a member's welcome message
+func createSleeperUserSelectMenu(discordID string, users []interactor.AvailableSleeperUser) *discordgo.SelectMenu {
 	options := make([]discordgo.SelectMenuOption, len(users))
 
 	for i, user := range users {
@@ -119,7 +132,7 @@ func (h *Handler) createSleeperUserSelectMenu(users []interactor.AvailableSleepe
 	}
 
 	return &discordgo.SelectMenu{
-		CustomID:    componentIDSleeperUserSelect,
+		CustomID:    sleeperUserSelectCustomID(discordID),
 		Placeholder: "Select your Sleeper account...",
 		MinValues:   &[]int{1}[0],
 		MaxValues:   1,
@@ -127,26 +140,37 @@ func (h *Handler) createSleeperUserSelectMenu(users []interactor.AvailableSleepe
 	}
 }
 
-// sendNoAvailableUsersMessage sends a message when all Sleeper accounts are already claimed
-func (h *Handler) sendNoAvailableUsersMessage(s *discordgo.Session, user *discordgo.User) error {
-	cfg := config.InitConfig()
-	welcomeChannelID := cfg.WelcomeChannelID
-
-	content := fmt.Sprintf(
+func noAvailableUsersContent(discordID string) string {
+	return fmt.Sprintf(
 		"<@%s> **Welcome to the dynasty league Discord!** 🏈\n\n"+
 			"Unfortunately, all Sleeper accounts have already been claimed by other Discord users. "+
 			"Please contact a league administrator if you believe this is an error or if you need assistance "+
 			"linking your account manually.",
-		user.ID,
+		discordID,
 	)
+}
 
-	_, err := s.ChannelMessageSend(welcomeChannelID, content)
-	if err != nil {
-		return fmt.Errorf("failed to send no available users message: %w", err)
+func sleeperUserSelectCustomID(discordID string) string {
+	return fmt.Sprintf("%s:%s", componentIDSleeperUserSelect, discordID)
+}
+
+func sleeperUserPageCustomID(discordID string, page int) string {
+	return fmt.Sprintf("%s:%s:%d", componentIDSleeperUserPage, discordID, page)
+}
+
+// parseSleeperUserPageCustomID returns the member and page of a welcome message page button
+func parseSleeperUserPageCustomID(customID string) (string, int, error) {
+	parts := strings.Split(customID, ":")
+	if len(parts) != 3 || parts[0] != componentIDSleeperUserPage {
+		return "", 0, fmt.Errorf("invalid welcome page custom ID: %s", customID)
 	}
 
-	log.Printf("Sent 'no available users' message to user %s (%s)", user.Username, user.ID)
-	return nil
+	page, err := strconv.Atoi(parts[2])
+	if err != nil || page < 0 {
+		return "", 0, fmt.Errorf("invalid page in welcome page custom ID: %s", customID)
+	}
+
+	return parts[1], page, nil
 }
 
 // HandleComponentInteraction processes Discord component interactions (like select menu selections)
@@ -158,9 +182,18 @@ func (h *Handler) HandleComponentInteraction(s *discordgo.Session, i *discordgo.
 	data := i.MessageComponentData()
 	ctx := context.Background()
 
-	switch data.CustomID {
-	case componentIDSleeperUserSelect:
+	page, _, _ := strings.Cut(data.CustomID, ":")
+	switch {
+	case page == componentIDSleeperUserSelect:
 		h.handleSleeperUserSelection(ctx, s, i, data)
+	case page == componentIDSleeperUserPage:
+		h.handleSleeperUserPage(s, i, data.CustomID)
+	case page == componentIDStandingsPage, page == componentIDWeeklySummaryPage:
+		h.handlePageButton(s, i, page, data.CustomID)
+	case page == componentIDProfile:
+		h.handleProfileButton(s, i, data.CustomID)
+	case page == componentIDOnboardingRetry:
+		h.handleOnboardingRetry(s, i, data.CustomID)
 	default:
 		log.Printf("Unknown component interaction: %s", data.CustomID)
 	}
@@ -174,7 +207,13 @@ func (h *Handler) handleSleeperUserSelection(ctx context.Context, s *discordgo.S
 	}
 
 	selectedSleeperUserID := data.Values[0]
-	discordUserID := i.Member.User.ID
+	discordUserID := interactionUserID(i)
+
+	// Welcome messages sent before they were private don't name their member
+	if _, welcomedID, _ := strings.Cut(data.CustomID, ":"); welcomedID != "" && welcomedID != discordUserID {
+		h.respondWithError(s, i, notYourWelcomeMessage(welcomedID))
+		return
+	}
 
 	// Attempt to link the accounts
 	err := h.interactor.LinkDiscordToSleeperUser(ctx, discordUserID, selectedSleeperUserID)
@@ -184,25 +223,174 @@ func (h *Handler) handleSleeperUserSelection(ctx context.Context, s *discordgo.S
 		return
 	}
 
-	// Success - update the message to show completion
-	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseUpdateMessage,
-		Data: &discordgo.InteractionResponseData{
-			Content: fmt.Sprintf(
-				"✅ **Successfully linked your Discord account!**\n\n"+
-					"<@%s>, you can now use all bot commands like `/career-stats` and `/standings`. "+
-					"Welcome to the league! 🏈",
-				discordUserID,
-			),
-			Components: []discordgo.MessageComponent{}, // Remove the select menu
-		},
-	})
+	log.Printf("Successfully linked Discord user %s to Sleeper user %s", discordUserID, selectedSleeperUserID)
+
+	// Setting the member up can take longer than Discord's 3 second deadline, so acknowledge the selection first
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		log.Printf("Failed to acknowledge Sleeper account selection: %v", err)
+	}
+
+	// Finish setting the member up, then replace the select menu with the outcome
+	content, components := onboardingOutcome(discordUserID, h.completeOnboarding(ctx, dependency.NewDiscordWrapper(s), discordUserID))
+	h.onAccountLinked(ctx, discordUserID)
+	h.respondConfirmation(s, i, content, components)
+}
+
+// handleSleeperUserPage shows another page of Sleeper accounts on a member's welcome message
+func (h *Handler) handleSleeperUserPage(s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		log.Printf("error deferring response to component %s: %v", customID, err)
+		return
+	}
+
+	discordID, page, err := parseSleeperUserPageCustomID(customID)
+	if err != nil {
+		h.respondPageError(s, i, "Hmm... I couldn't read that button.", err)
+		return
+	}
+	if discordID != interactionUserID(i) {
+		h.respondPageError(s, i, notYourWelcomeMessage(discordID), fmt.Errorf("%s clicked %s", interactionUserID(i), customID))
+		return
+	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	availableUsers, err := h.interactor.GetAvailableSleeperUsers(ctx)
 	if err != nil {
-		log.Printf("Failed to update welcome message after successful linking: %v", err)
-	} else {
-		log.Printf("Successfully linked Discord user %s to Sleeper user %s", discordUserID, selectedSleeperUserID)
+		h.respondPageError(s, i, "Hmm... I couldn't get the available Sleeper accounts.", err)
+		return
+	}
+
+	content, components := welcomeMessage(discordID, availableUsers, page)
+	h.respondConfirmation(s, i, content, components)
+}
+
+// notYourWelcomeMessage is shown to anyone using a welcome message meant for someone else
+func notYourWelcomeMessage(discordID string) string {
+	return fmt.Sprintf("This welcome message is for <@%s>. Use `/link` to link your own Sleeper account.", discordID)
+}
+
+// onAccountLinked closes the member's welcome message and refreshes everyone else's, now that one less account is available.
+// Linking already succeeded, so errors are only logged.
+func (h *Handler) onAccountLinked(ctx context.Context, discordID string) {
+	if err := h.welcomeMessages.Close(ctx, discordID); err != nil {
+		log.Printf("⚠️ %v", err)
+	}
+	h.refreshWelcomeMessages(ctx)
+}
+
+// refreshWelcomeMessages updates open welcome messages after an account is linked or unlinked
+func (h *Handler) refreshWelcomeMessages(ctx context.Context) {
+	if err := h.welcomeMessages.Refresh(ctx); err != nil {
+		log.Printf("⚠️ Failed to refresh welcome messages: %v", err)
+	}
+}
+
+// completeOnboarding sets a member up in Discord once they have linked their Sleeper account: it gives them the
+// manager role, optionally sets their nickname to their team name, marks them as onboarded and welcomes them publicly.
+// If Discord refuses the role or nickname (usually missing permissions), the member stays linked but not onboarded
+// so it can be retried.
+func (h *Handler) completeOnboarding(ctx context.Context, s dependency.IDiscordSession, discordID string) error {
+	user, err := h.interactor.GetLinkedUser(ctx, discordID)
+	if err != nil {
+		return err
+	}
+
+	if h.managerRoleID != "" {
+		if err := s.GuildMemberRoleAdd(h.guildID, discordID, h.managerRoleID); err != nil {
+			return fmt.Errorf("failed to assign the manager role: %w", err)
+		}
+	}
+
+	teamName, err := h.interactor.GetTeamName(ctx, user.ID)
+	if err != nil || teamName == "" {
+		log.Printf("Couldn't get team name for Sleeper user %s, using their name instead: %v", user.ID, err)
+		teamName = user.Name
+	}
+
+	if h.setNicknames {
+		if err := s.GuildMemberNickname(h.guildID, discordID, truncate(teamName, nicknameLimit)); err != nil {
+			return fmt.Errorf("failed to set nickname: %w", err)
+		}
+	}
+
+	if err := h.interactor.CompleteOnboarding(ctx, discordID); err != nil {
+		return err
+	}
+
+	// The member is set up either way, so a failed announcement is only logged
+	if _, err := s.ChannelMessageSend(h.welcomeChannelID, welcomeAnnouncement(discordID, teamName)); err != nil {
+		log.Printf("Failed to announce onboarded user %s: %v", discordID, err)
 	}
+
+	log.Printf("✅ Completed onboarding for Discord user %s (%s)", discordID, user.Name)
+	return nil
+}
+
+// welcomeAnnouncement is the public welcome posted once a member is onboarded
+func welcomeAnnouncement(discordID, teamName string) string {
+	return fmt.Sprintf("🎉 Please welcome <@%s>, manager of **%s**, to the league!", discordID, teamName)
+}
+
+// onboardingOutcome returns the message shown after a member links their account, with a retry button if
+// completing their onboarding failed
+func onboardingOutcome(discordID string, err error) (string, []discordgo.MessageComponent) {
+	if err == nil {
+		return fmt.Sprintf(
+			"✅ **Successfully linked your Discord account!**\n\n"+
+				"<@%s>, you can now use all bot commands like `/career-stats` and `/standings`. "+
+				"Welcome to the league! 🏈",
+			discordID,
+		), []discordgo.MessageComponent{}
+	}
+
+	log.Printf("Failed to complete onboarding for Discord user %s: %v", discordID, err)
+	return fmt.Sprintf(
+		"⚠️ <@%s>, your Discord account is linked, but I couldn't finish setting you up in the server. "+
+			"This is usually a permissions problem on my end, so ask the commissioner to take a look, then press Retry.",
+		discordID,
+	), []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Retry",
+					Style:    discordgo.PrimaryButton,
+					CustomID: onboardingRetryCustomID(discordID),
+				},
+			},
+		},
+	}
+}
+
+func onboardingRetryCustomID(discordID string) string {
+	return fmt.Sprintf("%s:%s", componentIDOnboardingRetry, discordID)
+}
+
+// handleOnboardingRetry retries completing a member's onboarding. The member or a commissioner can retry.
+func (h *Handler) handleOnboardingRetry(s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		log.Printf("error deferring response to component %s: %v", customID, err)
+		return
+	}
+
+	_, discordID, _ := strings.Cut(customID, ":")
+	if discordID != interactionUserID(i) && !h.isCommissioner(i.Member) {
+		h.respondPageError(s, i, "Only the member being set up or the commissioner can retry.", fmt.Errorf("%s clicked %s", interactionUserID(i), customID))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	content, components := onboardingOutcome(discordID, h.completeOnboarding(ctx, dependency.NewDiscordWrapper(s), discordID))
+	h.respondConfirmation(s, i, content, components)
 }
 
 // respondWithError sends an error response to a Discord interaction
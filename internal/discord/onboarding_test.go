@@ -3,14 +3,17 @@ package discord
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // testableOnboardingHandler creates a handler with mock dependencies for testing onboarding
@@ -113,6 +116,13 @@ type mockOnboardingInteractor struct {
 	GetAvailableSleeperUsersFunc func(ctx context.Context) ([]interactor.AvailableSleeperUser, error)
 	LinkDiscordToSleeperUserFunc func(ctx context.Context, discordID, sleeperUserID string) error
 	IsUserOnboardedFunc          func(ctx context.Context, discordID string) (bool, error)
+	GetLinkedUserFunc            func(ctx context.Context, discordID string) (domain.User, error)
+	CompleteOnboardingFunc       func(ctx context.Context, discordID string) error
+	GetTeamNameFunc              func(ctx context.Context, userID string) (string, error)
+	RecordWelcomeMessageFunc     func(ctx context.Context, discordID, channelID, messageID string) error
+	GetWelcomeMessagesFunc       func(ctx context.Context) (domain.WelcomeMessages, error)
+	RemoveWelcomeMessageFunc     func(ctx context.Context, discordID string) (*domain.WelcomeMessage, error)
+	RecordOnboardingReminderFunc func(ctx context.Context, discordID string) error
 }
 
 func (m *mockOnboardingInteractor) GetAvailableSleeperUsers(ctx context.Context) ([]interactor.AvailableSleeperUser, error) {
@@ -136,6 +146,63 @@ func (m *mockOnboardingInteractor) IsUserOnboarded(ctx context.Context, discordI
 	return false, nil
 }
 
+func (m *mockOnboardingInteractor) GetLinkedUser(ctx context.Context, discordID string) (domain.User, error) {
+	if m.GetLinkedUserFunc != nil {
+		return m.GetLinkedUserFunc(ctx, discordID)
+	}
+	return domain.User{}, interactor.ErrNotLinked
+}
+
+func (m *mockOnboardingInteractor) UnlinkDiscordUser(ctx context.Context, discordID string) (domain.User, error) {
+	return domain.User{}, nil
+}
+
+func (m *mockOnboardingInteractor) SetEmailForDiscordUser(ctx context.Context, discordID, email string) (domain.User, error) {
+	return domain.User{}, nil
+}
+
+func (m *mockOnboardingInteractor) CompleteOnboarding(ctx context.Context, discordID string) error {
+	if m.CompleteOnboardingFunc != nil {
+		return m.CompleteOnboardingFunc(ctx, discordID)
+	}
+	return nil
+}
+
+func (m *mockOnboardingInteractor) GetTeamName(ctx context.Context, userID string) (string, error) {
+	if m.GetTeamNameFunc != nil {
+		return m.GetTeamNameFunc(ctx, userID)
+	}
+	return "", nil
+}
+
+func (m *mockOnboardingInteractor) RecordWelcomeMessage(ctx context.Context, discordID, channelID, messageID string) error {
+	if m.RecordWelcomeMessageFunc != nil {
+		return m.RecordWelcomeMessageFunc(ctx, discordID, channelID, messageID)
+	}
+	return nil
+}
+
+func (m *mockOnboardingInteractor) GetWelcomeMessages(ctx context.Context) (domain.WelcomeMessages, error) {
+	if m.GetWelcomeMessagesFunc != nil {
+		return m.GetWelcomeMessagesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockOnboardingInteractor) RemoveWelcomeMessage(ctx context.Context, discordID string) (*domain.WelcomeMessage, error) {
+	if m.RemoveWelcomeMessageFunc != nil {
+		return m.RemoveWelcomeMessageFunc(ctx, discordID)
+	}
+	return nil, nil
+}
+
+func (m *mockOnboardingInteractor) RecordOnboardingReminder(ctx context.Context, discordID string) error {
+	if m.RecordOnboardingReminderFunc != nil {
+		return m.RecordOnboardingReminderFunc(ctx, discordID)
+	}
+	return nil
+}
+
 // mockFullInteractor combines all interactor interfaces for testing
 type mockFullInteractor struct {
 	*mockOnboardingInteractor
@@ -143,6 +210,16 @@ type mockFullInteractor struct {
 	interactor.StatsInteractor
 	interactor.UsersInteractor
 	interactor.WeeklyJobInteractor
+	interactor.LedgerInteractor
+	interactor.DuesInteractor
+	interactor.SeasonInteractor
+	interactor.YearInReviewInteractor
+	interactor.AdminInteractor
+	interactor.FranchiseInteractor
+	interactor.TransactionInteractor
+	interactor.DraftInteractor
+	interactor.KeeperInteractor
+	interactor.WaiverInteractor
 }
 
 func TestOnGuildMemberAdd(t *testing.T) {
@@ -322,11 +399,9 @@ func TestCreateSleeperUserSelectMenu(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			handler := &Handler{}
+			selectMenu := createSleeperUserSelectMenu("discord1", tt.users)
 
-			selectMenu := handler.createSleeperUserSelectMenu(tt.users)
-
-			assert.Equal(t, componentIDSleeperUserSelect, selectMenu.CustomID)
+			assert.Equal(t, "sleeper_user_select:discord1", selectMenu.CustomID)
 			assert.Equal(t, "Select your Sleeper account...", selectMenu.Placeholder)
 			assert.Equal(t, 1, *selectMenu.MinValues)
 			assert.Equal(t, 1, selectMenu.MaxValues)
@@ -353,6 +428,77 @@ func TestCreateSleeperUserSelectMenu(t *testing.T) {
 	}
 }
 
+func TestWelcomeMessage_Pages(t *testing.T) {
+	users := make([]interactor.AvailableSleeperUser, 30)
+	for i := range users {
+		users[i] = interactor.AvailableSleeperUser{SleeperUserID: fmt.Sprintf("sleeper%d", i), DisplayName: "Manager", Username: "manager"}
+	}
+
+	t.Run("fits on one page without buttons", func(t *testing.T) {
+		content, components := welcomeMessage("discord1", users[:selectMenuOptionLimit], 0)
+		assert.NotContains(t, content, "Use the buttons")
+		require.Len(t, components, 1)
+		assert.Len(t, welcomeSelectMenu(t, components).Options, selectMenuOptionLimit)
+	})
+
+	t.Run("first page", func(t *testing.T) {
+		content, components := welcomeMessage("discord1", users, 0)
+		assert.Contains(t, content, "Use the buttons")
+		require.Len(t, components, 2)
+
+		menu := welcomeSelectMenu(t, components)
+		assert.Len(t, menu.Options, selectMenuOptionLimit)
+		assert.Equal(t, "sleeper0", menu.Options[0].Value)
+
+		buttons := rowButtons(t, components[1:])
+		assert.True(t, buttons[0].Disabled)
+		assert.Equal(t, "Page 1 of 2", buttons[1].Label)
+		assert.False(t, buttons[2].Disabled)
+		assert.Equal(t, "sleeper_user_page:discord1:1", buttons[2].CustomID)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		_, components := welcomeMessage("discord1", users, 1)
+		require.Len(t, components, 2)
+
+		menu := welcomeSelectMenu(t, components)
+		assert.Len(t, menu.Options, 5)
+		assert.Equal(t, "sleeper25", menu.Options[0].Value)
+
+		buttons := rowButtons(t, components[1:])
+		assert.Equal(t, "sleeper_user_page:discord1:0", buttons[0].CustomID)
+		assert.Equal(t, "Page 2 of 2", buttons[1].Label)
+		assert.True(t, buttons[2].Disabled)
+	})
+
+	t.Run("clamps pages that no longer exist", func(t *testing.T) {
+		_, components := welcomeMessage("discord1", users[:3], 4)
+		require.Len(t, components, 1)
+		assert.Len(t, welcomeSelectMenu(t, components).Options, 3)
+	})
+}
+
+func welcomeSelectMenu(t *testing.T, components []discordgo.MessageComponent) *discordgo.SelectMenu {
+	t.Helper()
+	row, ok := components[0].(discordgo.ActionsRow)
+	require.True(t, ok)
+	menu, ok := row.Components[0].(*discordgo.SelectMenu)
+	require.True(t, ok)
+	return menu
+}
+
+func TestParseSleeperUserPageCustomID(t *testing.T) {
+	discordID, page, err := parseSleeperUserPageCustomID(sleeperUserPageCustomID("discord1", 2))
+	require.NoError(t, err)
+	assert.Equal(t, "discord1", discordID)
+	assert.Equal(t, 2, page)
+
+	for _, customID := range []string{"sleeper_user_page:discord1", "sleeper_user_page:discord1:-1", "standings:discord1:2", "sleeper_user_page:disabled:prev"} {
+		_, _, err := parseSleeperUserPageCustomID(customID)
+		assert.Error(t, err, customID)
+	}
+}
+
 func TestHandleSleeperUserSelection(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -448,3 +594,150 @@ func TestHandleSleeperUserSelection(t *testing.T) {
 		})
 	}
 }
+
+func TestCompleteOnboarding(t *testing.T) {
+	linkedUser := domain.User{ID: "sleeper1", Name: "John Doe", DiscordID: "discord1"}
+
+	tests := []struct {
+		name            string
+		managerRoleID   string
+		setNicknames    bool
+		teamName        string
+		roleAddError    error
+		nicknameError   error
+		expectError     string
+		expectRole      bool
+		expectNickname  string
+		expectCompleted bool
+		expectWelcome   string
+	}{
+		{
+			name:            "assigns role, sets nickname, completes and welcomes",
+			managerRoleID:   "manager-role",
+			setNicknames:    true,
+			teamName:        "The Dynasty Kings",
+			expectRole:      true,
+			expectNickname:  "The Dynasty Kings",
+			expectCompleted: true,
+			expectWelcome:   "🎉 Please welcome <@discord1>, manager of **The Dynasty Kings**, to the league!",
+		},
+		{
+			name:            "skips role and nickname when not configured",
+			teamName:        "The Dynasty Kings",
+			expectCompleted: true,
+			expectWelcome:   "🎉 Please welcome <@discord1>, manager of **The Dynasty Kings**, to the league!",
+		},
+		{
+			name:            "falls back to the user's name without a team name",
+			setNicknames:    true,
+			expectNickname:  "John Doe",
+			expectCompleted: true,
+			expectWelcome:   "🎉 Please welcome <@discord1>, manager of **John Doe**, to the league!",
+		},
+		{
+			name:            "truncates long nicknames",
+			setNicknames:    true,
+			teamName:        "The Incredibly Long Dynasty Team Name Kings",
+			expectNickname:  "The Incredibly Long Dynasty Tea…",
+			expectCompleted: true,
+			expectWelcome:   "🎉 Please welcome <@discord1>, manager of **The Incredibly Long Dynasty Team Name Kings**, to the league!",
+		},
+		{
+			name:          "role failure leaves onboarding incomplete",
+			managerRoleID: "manager-role",
+			setNicknames:  true,
+			roleAddError:  errors.New("missing permissions"),
+			expectError:   "failed to assign the manager role",
+			expectRole:    true,
+		},
+		{
+			name:           "nickname failure leaves onboarding incomplete",
+			managerRoleID:  "manager-role",
+			setNicknames:   true,
+			teamName:       "The Dynasty Kings",
+			nicknameError:  errors.New("missing permissions"),
+			expectError:    "failed to set nickname",
+			expectRole:     true,
+			expectNickname: "The Dynasty Kings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var roleAdded bool
+			var nickname, welcome string
+			var completed bool
+
+			session := &dependency.MockDiscordSession{
+				GuildMemberRoleAddFunc: func(guildID, userID, roleID string, options ...discordgo.RequestOption) error {
+					roleAdded = true
+					assert.Equal(t, "guild", guildID)
+					assert.Equal(t, "discord1", userID)
+					assert.Equal(t, tt.managerRoleID, roleID)
+					return tt.roleAddError
+				},
+				GuildMemberNicknameFunc: func(guildID, userID, nick string, options ...discordgo.RequestOption) error {
+					nickname = nick
+					return tt.nicknameError
+				},
+				ChannelMessageSendFunc: func(channelID, content string) (*discordgo.Message, error) {
+					assert.Equal(t, "welcome-channel", channelID)
+					welcome = content
+					return &discordgo.Message{}, nil
+				},
+			}
+
+			h := &Handler{
+				interactor: &mockFullInteractor{
+					mockOnboardingInteractor: &mockOnboardingInteractor{
+						GetLinkedUserFunc: func(ctx context.Context, discordID string) (domain.User, error) {
+							return linkedUser, nil
+						},
+						GetTeamNameFunc: func(ctx context.Context, userID string) (string, error) {
+							return tt.teamName, nil
+						},
+						CompleteOnboardingFunc: func(ctx context.Context, discordID string) error {
+							completed = true
+							return nil
+						},
+					},
+				},
+				guildID:          "guild",
+				welcomeChannelID: "welcome-channel",
+				managerRoleID:    tt.managerRoleID,
+				setNicknames:     tt.setNicknames,
+			}
+
+			err := h.completeOnboarding(context.Background(), session, "discord1")
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectRole, roleAdded)
+			assert.Equal(t, tt.expectNickname, nickname)
+			assert.Equal(t, tt.expectCompleted, completed)
+			assert.Equal(t, tt.expectWelcome, welcome)
+		})
+	}
+}
+
+func TestCompleteOnboarding_NotLinked(t *testing.T) {
+	h := &Handler{interactor: &mockFullInteractor{mockOnboardingInteractor: &mockOnboardingInteractor{}}}
+
+	err := h.completeOnboarding(context.Background(), &dependency.MockDiscordSession{}, "discord1")
+	assert.ErrorIs(t, err, interactor.ErrNotLinked)
+}
+
+func TestOnboardingOutcome(t *testing.T) {
+	content, components := onboardingOutcome("discord1", nil)
+	assert.Contains(t, content, "Successfully linked your Discord account")
+	assert.Empty(t, components)
+
+	content, components = onboardingOutcome("discord1", errors.New("failed to assign the manager role"))
+	assert.Contains(t, content, "press Retry")
+	if assert.Len(t, components, 1) {
+		row := components[0].(discordgo.ActionsRow)
+		assert.Equal(t, "onboarding_retry:discord1", row.Components[0].(discordgo.Button).CustomID)
+	}
+}
@@ -0,0 +1,237 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Page button custom IDs encode the page they lead to (e.g. "standings:2023:regular" or "weekly:2024:5"),
+// so any button can be clicked at any time without the bot remembering which page a message is on.
+const (
+	componentIDStandingsPage     = "standings"
+	componentIDWeeklySummaryPage = "weekly"
+)
+
+// pageTruncatedFooter replaces the footer of a page too long to show in one message
+const pageTruncatedFooter = embedFooterText + " • Too long to show in full"
+
+// Standings views. The final view includes playoff results once a league is complete.
+const (
+	standingsViewFinal   = "final"
+	standingsViewRegular = "regular"
+)
+
+func standingsCustomID(year int, view string) string {
+	return fmt.Sprintf("%s:%d:%s", componentIDStandingsPage, year, view)
+}
+
+// parseStandingsCustomID returns the year and view of a standings button
+func parseStandingsCustomID(customID string) (int, string, error) {
+	parts := strings.Split(customID, ":")
+	if len(parts) != 3 || parts[0] != componentIDStandingsPage {
+		return 0, "", fmt.Errorf("invalid standings custom ID: %s", customID)
+	}
+
+	year, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid year in standings custom ID %s: %w", customID, err)
+	}
+
+	view := parts[2]
+	if view != standingsViewFinal && view != standingsViewRegular {
+		return 0, "", fmt.Errorf("invalid view in standings custom ID: %s", customID)
+	}
+
+	return year, view, nil
+}
+
+// weeklySummaryCustomID returns the custom ID of a weekly summary button. A week of 0 means the year's latest completed week.
+func weeklySummaryCustomID(year, week int) string {
+	return fmt.Sprintf("%s:%d:%d", componentIDWeeklySummaryPage, year, week)
+}
+
+// parseWeeklySummaryCustomID returns the year and week of a weekly summary button
+func parseWeeklySummaryCustomID(customID string) (int, int, error) {
+	parts := strings.Split(customID, ":")
+	if len(parts) != 3 || parts[0] != componentIDWeeklySummaryPage {
+		return 0, 0, fmt.Errorf("invalid weekly summary custom ID: %s", customID)
+	}
+
+	year, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid year in weekly summary custom ID %s: %w", customID, err)
+	}
+
+	week, err := strconv.Atoi(parts[2])
+	if err != nil || week < 0 {
+		return 0, 0, fmt.Errorf("invalid week in weekly summary custom ID: %s", customID)
+	}
+
+	return year, week, nil
+}
+
+// standingsComponents returns the buttons for flipping between years and, for completed leagues, between views
+func standingsComponents(years []int, league domain.League, view string) []discordgo.MessageComponent {
+	prev, next := adjacentYears(years, league.Year)
+
+	buttons := []discordgo.MessageComponent{
+		pageButton(componentIDStandingsPage, "prev-year", "◀ Previous Year", standingsCustomID(prev, standingsViewFinal), prev == 0),
+	}
+
+	if league.Status == domain.LeagueStatusComplete {
+		if view == standingsViewRegular {
+			buttons = append(buttons, pageButton(componentIDStandingsPage, "view", "🏆 Final", standingsCustomID(league.Year, standingsViewFinal), false))
+		} else {
+			buttons = append(buttons, pageButton(componentIDStandingsPage, "view", "📋 Regular Season", standingsCustomID(league.Year, standingsViewRegular), false))
+		}
+	}
+
+	buttons = append(buttons, pageButton(componentIDStandingsPage, "next-year", "Next Year ▶", standingsCustomID(next, standingsViewFinal), next == 0))
+
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+}
+
+// weeklySummaryComponents returns the buttons for flipping between weeks and years.
+// Switching years shows that year's latest completed week.
+func weeklySummaryComponents(years []int, summary *interactor.WeeklySummary) []discordgo.MessageComponent {
+	prevYear, nextYear := adjacentYears(years, summary.Year)
+	prevWeek, nextWeek := summary.Week-1, summary.Week+1
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			pageButton(componentIDWeeklySummaryPage, "prev-year", "⏪ Previous Year", weeklySummaryCustomID(prevYear, 0), prevYear == 0),
+			pageButton(componentIDWeeklySummaryPage, "prev-week", "◀ Previous Week", weeklySummaryCustomID(summary.Year, prevWeek), prevWeek < 1),
+			pageButton(componentIDWeeklySummaryPage, "next-week", "Next Week ▶", weeklySummaryCustomID(summary.Year, nextWeek), nextWeek > summary.LatestWeek),
+			pageButton(componentIDWeeklySummaryPage, "next-year", "Next Year ⏩", weeklySummaryCustomID(nextYear, 0), nextYear == 0),
+		}},
+	}
+}
+
+// pageButton creates a navigation button. Custom IDs must be unique within a message,
+// so disabled buttons (which have no page to point at) get a placeholder ID based on their position.
+func pageButton(page, position, label, customID string, disabled bool) discordgo.Button {
+	if disabled {
+		customID = fmt.Sprintf("%s:disabled:%s", page, position)
+	}
+
+	return discordgo.Button{
+		Label:    label,
+		Style:    discordgo.SecondaryButton,
+		CustomID: customID,
+		Disabled: disabled,
+	}
+}
+
+// adjacentYears returns the years before and after year in the sorted list of league years, or 0 if there isn't one
+func adjacentYears(years []int, year int) (int, int) {
+	idx, found := slices.BinarySearch(years, year)
+
+	var prev, next int
+	if idx > 0 {
+		prev = years[idx-1]
+	}
+	if found {
+		idx++
+	}
+	if idx < len(years) {
+		next = years[idx]
+	}
+	return prev, next
+}
+
+// handlePageButton acknowledges a page button right away, then edits the message in place with the new page
+func (h *Handler) handlePageButton(s *discordgo.Session, i *discordgo.InteractionCreate, page, customID string) {
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		log.Printf("error deferring response to component %s: %v", customID, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	switch page {
+	case componentIDStandingsPage:
+		h.handleStandingsPage(ctx, s, i, customID)
+	case componentIDWeeklySummaryPage:
+		h.handleWeeklySummaryPage(ctx, s, i, customID)
+	}
+}
+
+// leagueYears returns the years that have standings to page through.
+// Without them the year buttons are just disabled, so errors are logged and not returned.
+func (h *Handler) leagueYears(ctx context.Context) []int {
+	years, err := h.interactor.GetLeagueYears(ctx)
+	if err != nil {
+		log.Printf("error getting league years: %v", err)
+		return nil
+	}
+	return years
+}
+
+// respondPage replaces the response with a page embed and its navigation buttons.
+// It works for both deferred commands and deferred button clicks, which edit the message in place.
+func (h *Handler) respondPage(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed, components []discordgo.MessageComponent) {
+	embeds := pageEmbeds(embed)
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &embeds,
+		Components: &components,
+	}); err != nil {
+		log.Printf("error responding to interaction: %s", err.Error())
+	}
+}
+
+// pageEmbeds splits a page embed to fit Discord's limits. Unlike RespondEmbeds, a page can't continue in
+// follow-up messages, which would pile up every time someone flips the page, so anything that doesn't fit
+// in one message is cut off and the page says so in its footer.
+func pageEmbeds(embed *discordgo.MessageEmbed) []*discordgo.MessageEmbed {
+	messages := batchEmbeds(splitEmbed(embed))
+	if len(messages) == 1 {
+		return messages[0]
+	}
+
+	log.Printf("page %q is too long for one message, cutting it off", embed.Title)
+
+	// Split again with the footer that will be shown, so every embed leaves room for it
+	truncated := *embed
+	truncated.Footer = &discordgo.MessageEmbedFooter{Text: pageTruncatedFooter}
+	embeds := batchEmbeds(splitEmbed(&truncated))[0]
+
+	length := 0
+	for _, e := range embeds {
+		length += embedLength(e)
+	}
+	for len(embeds) > 1 && length+utf8.RuneCountInString(pageTruncatedFooter) > embedTotalLimit {
+		length -= embedLength(embeds[len(embeds)-1])
+		embeds = embeds[:len(embeds)-1]
+	}
+
+	last := *embeds[len(embeds)-1]
+	last.Footer = truncated.Footer
+	embeds[len(embeds)-1] = &last
+	return embeds
+}
+
+// respondPageError tells the user who clicked a page button what went wrong without replacing the page
+func (h *Handler) respondPageError(s *discordgo.Session, i *discordgo.InteractionCreate, message string, err error) {
+	log.Printf("error handling component %s: %v", i.MessageComponentData().CustomID, err)
+
+	if _, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content: errorMessage(message, err),
+		Flags:   discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		log.Printf("error sending follow-up message: %s", err.Error())
+	}
+}
@@ -0,0 +1,175 @@
+package discord
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandingsCustomID_RoundTrip(t *testing.T) {
+	year, view, err := parseStandingsCustomID(standingsCustomID(2023, standingsViewRegular))
+	require.NoError(t, err)
+	assert.Equal(t, 2023, year)
+	assert.Equal(t, standingsViewRegular, view)
+
+	for _, id := range []string{"standings:2023", "standings:abc:final", "standings:2023:playoffs", "weekly:2023:final", "standings:disabled:prev-year"} {
+		_, _, err := parseStandingsCustomID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestWeeklySummaryCustomID_RoundTrip(t *testing.T) {
+	year, week, err := parseWeeklySummaryCustomID(weeklySummaryCustomID(2024, 5))
+	require.NoError(t, err)
+	assert.Equal(t, 2024, year)
+	assert.Equal(t, 5, week)
+
+	for _, id := range []string{"weekly:2024", "weekly:2024:-1", "weekly:2024:five", "standings:2024:5", "weekly:disabled:next-week"} {
+		_, _, err := parseWeeklySummaryCustomID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestAdjacentYears(t *testing.T) {
+	years := []int{2021, 2022, 2024}
+
+	tests := []struct {
+		year         int
+		wantPrevious int
+		wantNext     int
+	}{
+		{year: 2021, wantPrevious: 0, wantNext: 2022},
+		{year: 2022, wantPrevious: 2021, wantNext: 2024},
+		{year: 2023, wantPrevious: 2022, wantNext: 2024},
+		{year: 2024, wantPrevious: 2022, wantNext: 0},
+		{year: 2025, wantPrevious: 2024, wantNext: 0},
+	}
+
+	for _, tt := range tests {
+		prev, next := adjacentYears(years, tt.year)
+		assert.Equal(t, tt.wantPrevious, prev, "previous year of %d", tt.year)
+		assert.Equal(t, tt.wantNext, next, "next year of %d", tt.year)
+	}
+
+	prev, next := adjacentYears(nil, 2024)
+	assert.Zero(t, prev)
+	assert.Zero(t, next)
+}
+
+func TestStandingsComponents(t *testing.T) {
+	years := []int{2023, 2024}
+
+	t.Run("completed league can toggle to regular season", func(t *testing.T) {
+		buttons := rowButtons(t, standingsComponents(years, domain.League{Year: 2023, Status: domain.LeagueStatusComplete}, standingsViewFinal))
+		require.Len(t, buttons, 3)
+
+		assert.True(t, buttons[0].Disabled)
+		assert.Equal(t, standingsCustomID(2023, standingsViewRegular), buttons[1].CustomID)
+		assert.False(t, buttons[2].Disabled)
+		assert.Equal(t, standingsCustomID(2024, standingsViewFinal), buttons[2].CustomID)
+		assertUniqueCustomIDs(t, buttons)
+	})
+
+	t.Run("regular season view toggles back to final", func(t *testing.T) {
+		buttons := rowButtons(t, standingsComponents(years, domain.League{Year: 2023, Status: domain.LeagueStatusComplete}, standingsViewRegular))
+		require.Len(t, buttons, 3)
+		assert.Equal(t, standingsCustomID(2023, standingsViewFinal), buttons[1].CustomID)
+	})
+
+	t.Run("in progress league has no toggle", func(t *testing.T) {
+		buttons := rowButtons(t, standingsComponents(years, domain.League{Year: 2024, Status: domain.LeagueStatusInProgress}, standingsViewFinal))
+		require.Len(t, buttons, 2)
+
+		assert.Equal(t, standingsCustomID(2023, standingsViewFinal), buttons[0].CustomID)
+		assert.True(t, buttons[1].Disabled)
+		assertUniqueCustomIDs(t, buttons)
+	})
+}
+
+func TestWeeklySummaryComponents(t *testing.T) {
+	years := []int{2023, 2024}
+
+	t.Run("latest week of the latest year", func(t *testing.T) {
+		buttons := rowButtons(t, weeklySummaryComponents(years, &interactor.WeeklySummary{Year: 2024, Week: 5, LatestWeek: 5}))
+		require.Len(t, buttons, 4)
+
+		assert.Equal(t, weeklySummaryCustomID(2023, 0), buttons[0].CustomID)
+		assert.Equal(t, weeklySummaryCustomID(2024, 4), buttons[1].CustomID)
+		assert.True(t, buttons[2].Disabled)
+		assert.True(t, buttons[3].Disabled)
+		assertUniqueCustomIDs(t, buttons)
+	})
+
+	t.Run("first week of the first year", func(t *testing.T) {
+		buttons := rowButtons(t, weeklySummaryComponents(years, &interactor.WeeklySummary{Year: 2023, Week: 1, LatestWeek: 17}))
+		require.Len(t, buttons, 4)
+
+		assert.True(t, buttons[0].Disabled)
+		assert.True(t, buttons[1].Disabled)
+		assert.Equal(t, weeklySummaryCustomID(2023, 2), buttons[2].CustomID)
+		assert.Equal(t, weeklySummaryCustomID(2024, 0), buttons[3].CustomID)
+		assertUniqueCustomIDs(t, buttons)
+	})
+}
+
+// rowButtons returns the buttons of a single action row
+func rowButtons(t *testing.T, components []discordgo.MessageComponent) []discordgo.Button {
+	t.Helper()
+	require.Len(t, components, 1)
+
+	row, ok := components[0].(discordgo.ActionsRow)
+	require.True(t, ok)
+
+	var buttons []discordgo.Button
+	for _, c := range row.Components {
+		button, ok := c.(discordgo.Button)
+		require.True(t, ok)
+		buttons = append(buttons, button)
+	}
+	return buttons
+}
+
+func assertUniqueCustomIDs(t *testing.T, buttons []discordgo.Button) {
+	t.Helper()
+	seen := map[string]bool{}
+	for _, b := range buttons {
+		assert.False(t, seen[b.CustomID], "duplicate custom ID %s", b.CustomID)
+		seen[b.CustomID] = true
+	}
+}
+
+func TestPageEmbeds(t *testing.T) {
+	t.Run("a page that fits is unchanged", func(t *testing.T) {
+		e := newEmbed("Standings")
+		e.Fields = []*discordgo.MessageEmbedField{{Name: "Team", Value: "10-3"}}
+
+		embeds := pageEmbeds(e)
+
+		require.Len(t, embeds, 1)
+		assert.Equal(t, embedFooterText, embeds[0].Footer.Text)
+	})
+
+	t.Run("a page too long for one message is cut off", func(t *testing.T) {
+		e := newEmbed("Weekly Summary")
+		for i := 0; i < 20; i++ {
+			e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: "field", Value: strings.Repeat("y", 1000)})
+		}
+
+		embeds := pageEmbeds(e)
+
+		assert.Equal(t, "Weekly Summary", embeds[0].Title)
+		assert.Equal(t, pageTruncatedFooter, embeds[len(embeds)-1].Footer.Text)
+		length := 0
+		for _, embed := range embeds {
+			length += embedLength(embed)
+		}
+		assert.LessOrEqual(t, length, embedTotalLimit)
+		assert.LessOrEqual(t, len(embeds), messageEmbedLimit)
+	})
+}
@@ -0,0 +1,358 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Profile button custom IDs encode the action and the Discord user it was offered to
+// (e.g. "profile:link:<discord ID>:<sleeper ID>"), so only that user can confirm it.
+const componentIDProfile = "profile"
+
+// Profile button actions
+const (
+	profileActionLink   = "link"
+	profileActionUnlink = "unlink"
+	profileActionCancel = "cancel"
+)
+
+// Subcommands of /email
+const (
+	emailSet    = "set"
+	emailRemove = "remove"
+)
+
+func (h *Handler) linkCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameLink,
+			Description: "Link your Discord account to your Sleeper account",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "manager",
+					Description:  "Your Sleeper account",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		handle:    h.handleLinkCommand,
+		ephemeral: true,
+	}
+}
+
+func (h *Handler) unlinkCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameUnlink,
+			Description: "Unlink your Discord account from your Sleeper account",
+		},
+		handle:    h.handleUnlinkCommand,
+		ephemeral: true,
+	}
+}
+
+func (h *Handler) whoamiCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameWhoami,
+			Description: "Show the Sleeper account your Discord account is linked to",
+		},
+		handle:    h.handleWhoamiCommand,
+		ephemeral: true,
+	}
+}
+
+func (h *Handler) emailCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameEmail,
+			Description: "Choose where your weekly recap emails are sent",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        emailSet,
+					Description: "Send your weekly recap emails to an address",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "address",
+							Description: "Your email address",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        emailRemove,
+					Description: "Stop sending you weekly recap emails",
+				},
+			},
+		},
+		handle:    h.handleEmailCommand,
+		ephemeral: true,
+	}
+}
+
+// handleLinkCommand handles the /link Discord command, asking the user to confirm the Sleeper account they picked
+func (h *Handler) handleLinkCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	discordID := interactionUserID(i)
+
+	linked, err := h.interactor.GetLinkedUser(ctx, discordID)
+	if err == nil {
+		h.Respond(s, i, fmt.Sprintf("You're already linked to **%s**. Use `/unlink` first if that's the wrong account.", linked.Name))
+		return
+	}
+	if !errors.Is(err, interactor.ErrNotLinked) {
+		h.RespondError(s, i, "Hmm... I couldn't check your linked account.", err)
+		return
+	}
+
+	user, ok := h.manager(ctx, s, i, opts, "manager")
+	if !ok {
+		return
+	}
+	if user.DiscordID != "" {
+		h.Respond(s, i, linkErrorMessage(interactor.ErrSleeperUserClaimed, user))
+		return
+	}
+
+	h.respondWithConfirmation(s, i,
+		fmt.Sprintf("Link your Discord account to **%s**?", user.Name),
+		profileCustomID(profileActionLink, discordID, user.ID), "Link")
+}
+
+// handleUnlinkCommand handles the /unlink Discord command, asking the user to confirm before unlinking their account
+func (h *Handler) handleUnlinkCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	discordID := interactionUserID(i)
+
+	user, err := h.interactor.GetLinkedUser(ctx, discordID)
+	if errors.Is(err, interactor.ErrNotLinked) {
+		h.Respond(s, i, "Your Discord account isn't linked to a Sleeper account.")
+		return
+	}
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't check your linked account.", err)
+		return
+	}
+
+	h.respondWithConfirmation(s, i,
+		fmt.Sprintf("Unlink your Discord account from **%s**? You can link it again with `/link`.", user.Name),
+		profileCustomID(profileActionUnlink, discordID, ""), "Unlink")
+}
+
+// handleWhoamiCommand handles the /whoami Discord command
+func (h *Handler) handleWhoamiCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	user, err := h.interactor.GetLinkedUser(ctx, interactionUserID(i))
+	if errors.Is(err, interactor.ErrNotLinked) {
+		h.Respond(s, i, "Your Discord account isn't linked to a Sleeper account yet. Use `/link` to link it.")
+		return
+	}
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't check your linked account.", err)
+		return
+	}
+
+	h.Respond(s, i, whoamiMessage(user))
+}
+
+// handleEmailCommand handles the /email Discord command
+func (h *Handler) handleEmailCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	sub := subcommand(i.ApplicationCommandData().Options)
+	if sub == nil {
+		h.Respond(s, i, "Please choose a subcommand.")
+		return
+	}
+
+	var email string
+	if sub.Name == emailSet {
+		email = strings.TrimSpace(newCommandOptions(sub.Options).String("address"))
+	}
+
+	user, err := h.interactor.SetEmailForDiscordUser(ctx, interactionUserID(i), email)
+	if errors.Is(err, interactor.ErrNotLinked) {
+		h.Respond(s, i, "Your Discord account isn't linked to a Sleeper account yet. Use `/link` to link it first.")
+		return
+	}
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't update your email. Please check the address and try again.", err)
+		return
+	}
+
+	if email == "" {
+		h.Respond(s, i, fmt.Sprintf("✅ %s will no longer receive weekly recap emails.", user.Name))
+		return
+	}
+	h.Respond(s, i, fmt.Sprintf("✅ Weekly recap emails for %s will be sent to %s.", user.Name, email))
+}
+
+// respondWithConfirmation replaces the deferred response with a question and buttons to confirm or cancel it
+func (h *Handler) respondWithConfirmation(s *discordgo.Session, i *discordgo.InteractionCreate, question, confirmCustomID, confirmLabel string) {
+	discordID := interactionUserID(i)
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: confirmLabel, Style: discordgo.SuccessButton, CustomID: confirmCustomID},
+				discordgo.Button{Label: "Cancel", Style: discordgo.SecondaryButton, CustomID: profileCustomID(profileActionCancel, discordID, "")},
+			},
+		},
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content:    &question,
+		Components: &components,
+	}); err != nil {
+		log.Printf("error responding to interaction: %s", err.Error())
+	}
+}
+
+// handleProfileButton acknowledges a confirmation button right away, then replaces the question with the outcome
+func (h *Handler) handleProfileButton(s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		log.Printf("error deferring response to component %s: %v", customID, err)
+		return
+	}
+
+	action, discordID, sleeperID, err := parseProfileCustomID(customID)
+	if err != nil {
+		h.respondPageError(s, i, "Hmm... I don't know that button.", err)
+		return
+	}
+	if discordID != interactionUserID(i) {
+		h.respondPageError(s, i, "Only the member who ran the command can use these buttons.", fmt.Errorf("%s clicked %s", interactionUserID(i), customID))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	switch action {
+	case profileActionLink:
+		h.confirmLink(ctx, s, i, discordID, sleeperID)
+	case profileActionUnlink:
+		h.confirmUnlink(ctx, s, i, discordID)
+	case profileActionCancel:
+		h.respondConfirmation(s, i, "Cancelled, nothing was changed.", nil)
+	}
+}
+
+func (h *Handler) confirmLink(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, discordID, sleeperID string) {
+	users, err := h.interactor.GetUsers(ctx)
+	if err != nil {
+		h.respondPageError(s, i, "Hmm... I couldn't get users.", err)
+		return
+	}
+	user := users[sleeperID]
+
+	if err := h.interactor.LinkDiscordToSleeperUser(ctx, discordID, sleeperID); err != nil {
+		log.Printf("Failed to link Discord user %s to Sleeper user %s: %v", discordID, sleeperID, err)
+		h.respondConfirmation(s, i, linkErrorMessage(err, user), nil)
+		return
+	}
+
+	log.Printf("Successfully linked Discord user %s to Sleeper user %s", discordID, sleeperID)
+	content, components := onboardingOutcome(discordID, h.completeOnboarding(ctx, dependency.NewDiscordWrapper(s), discordID))
+	h.onAccountLinked(ctx, discordID)
+	h.respondConfirmation(s, i, content, components)
+}
+
+func (h *Handler) confirmUnlink(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, discordID string) {
+	user, err := h.interactor.UnlinkDiscordUser(ctx, discordID)
+	if errors.Is(err, interactor.ErrNotLinked) {
+		h.respondConfirmation(s, i, "Your Discord account isn't linked to a Sleeper account.", nil)
+		return
+	}
+	if err != nil {
+		h.respondPageError(s, i, "Hmm... I couldn't unlink your account.", err)
+		return
+	}
+
+	log.Printf("Discord user %s unlinked from Sleeper user %s", discordID, user.ID)
+	h.refreshWelcomeMessages(ctx)
+	h.respondConfirmation(s, i, fmt.Sprintf("✅ Unlinked your Discord account from **%s**.", user.Name), nil)
+}
+
+// respondConfirmation replaces a confirmation question with its outcome, replacing the buttons with components
+// (or removing them if there are none)
+func (h *Handler) respondConfirmation(s *discordgo.Session, i *discordgo.InteractionCreate, content string, components []discordgo.MessageComponent) {
+	if components == nil {
+		components = []discordgo.MessageComponent{}
+	}
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Components: &components,
+	}); err != nil {
+		log.Printf("error responding to interaction: %s", err.Error())
+	}
+}
+
+// linkErrorMessage explains why linking to a Sleeper user failed
+func linkErrorMessage(err error, user domain.User) string {
+	switch {
+	case errors.Is(err, interactor.ErrSleeperUserClaimed):
+		return fmt.Sprintf("**%s** has already been claimed by another Discord user. "+
+			"Please contact the commissioner if that isn't right.", user.Name)
+	case errors.Is(err, interactor.ErrDiscordUserLinked):
+		return "Your Discord account is already linked to a Sleeper account. Use `/unlink` first if that's the wrong account."
+	default:
+		return errorMessage("Hmm... I couldn't link your account.", err)
+	}
+}
+
+// whoamiMessage describes the Sleeper account a Discord user is linked to
+func whoamiMessage(user domain.User) string {
+	email := "not set (use `/email set` to get weekly recaps by email)"
+	if user.Email != "" {
+		email = user.Email
+	}
+	return fmt.Sprintf("You're linked to **%s** (Sleeper ID %s).\nRecap emails: %s", user.Name, user.ID, email)
+}
+
+func profileCustomID(action, discordID, sleeperID string) string {
+	if sleeperID == "" {
+		return fmt.Sprintf("%s:%s:%s", componentIDProfile, action, discordID)
+	}
+	return fmt.Sprintf("%s:%s:%s:%s", componentIDProfile, action, discordID, sleeperID)
+}
+
+// parseProfileCustomID returns the action of a profile button, the Discord user it was offered to and,
+// for links, the Sleeper user to link to
+func parseProfileCustomID(customID string) (string, string, string, error) {
+	parts := strings.Split(customID, ":")
+	if len(parts) < 3 || parts[0] != componentIDProfile || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid profile custom ID: %s", customID)
+	}
+
+	action, discordID := parts[1], parts[2]
+	switch {
+	case action == profileActionLink && len(parts) == 4 && parts[3] != "":
+		return action, discordID, parts[3], nil
+	case (action == profileActionUnlink || action == profileActionCancel) && len(parts) == 3:
+		return action, discordID, "", nil
+	default:
+		return "", "", "", fmt.Errorf("invalid profile custom ID: %s", customID)
+	}
+}
+
+// interactionUserID returns the Discord ID of the user who triggered an interaction, in a server or a DM
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package discord
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileCustomID_RoundTrip(t *testing.T) {
+	tests := []struct {
+		action    string
+		discordID string
+		sleeperID string
+	}{
+		{action: profileActionLink, discordID: "discord1", sleeperID: "sleeper1"},
+		{action: profileActionUnlink, discordID: "discord1"},
+		{action: profileActionCancel, discordID: "discord1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			customID := profileCustomID(tt.action, tt.discordID, tt.sleeperID)
+			// Discord limits custom IDs to 100 characters
+			assert.LessOrEqual(t, len(customID), 100)
+
+			action, discordID, sleeperID, err := parseProfileCustomID(customID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.action, action)
+			assert.Equal(t, tt.discordID, discordID)
+			assert.Equal(t, tt.sleeperID, sleeperID)
+		})
+	}
+}
+
+func TestParseProfileCustomID_Invalid(t *testing.T) {
+	for _, customID := range []string{
+		"",
+		"profile",
+		"profile:link:discord1",
+		"profile:link:discord1:",
+		"profile:unlink:discord1:sleeper1",
+		"profile:delete:discord1",
+		"profile:cancel:",
+		"standings:2024:final",
+	} {
+		t.Run(customID, func(t *testing.T) {
+			_, _, _, err := parseProfileCustomID(customID)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLinkErrorMessage(t *testing.T) {
+	user := domain.User{ID: "sleeper1", Name: "John Doe"}
+
+	assert.Contains(t, linkErrorMessage(fmt.Errorf("wrapped: %w", interactor.ErrSleeperUserClaimed), user), "**John Doe** has already been claimed")
+	assert.Contains(t, linkErrorMessage(interactor.ErrDiscordUserLinked, user), "Use `/unlink` first")
+	assert.Equal(t, "Hmm... I couldn't link your account.", linkErrorMessage(assert.AnError, user))
+}
+
+func TestWhoamiMessage(t *testing.T) {
+	assert.Equal(t,
+		"You're linked to **John Doe** (Sleeper ID sleeper1).\nRecap emails: john@example.com",
+		whoamiMessage(domain.User{ID: "sleeper1", Name: "John Doe", Email: "john@example.com"}))
+	assert.Contains(t, whoamiMessage(domain.User{ID: "sleeper1", Name: "John Doe"}), "Recap emails: not set")
+}
+
+func TestInteractionUserID(t *testing.T) {
+	member := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{Member: &discordgo.Member{User: TestUser("member1", "member")}}}
+	dm := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{User: TestUser("user1", "user")}}
+
+	assert.Equal(t, "member1", interactionUserID(member))
+	assert.Equal(t, "user1", interactionUserID(dm))
+	assert.Empty(t, interactionUserID(&discordgo.InteractionCreate{Interaction: &discordgo.Interaction{}}))
+}
+
+func TestProfileCommands_AreEphemeral(t *testing.T) {
+	h := &Handler{}
+	for _, c := range []command{h.linkCommand(), h.unlinkCommand(), h.whoamiCommand(), h.emailCommand()} {
+		assert.True(t, c.ephemeral, c.definition.Name)
+	}
+}
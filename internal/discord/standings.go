@@ -2,23 +2,63 @@ package discord
 
 import (
 	"context"
-	"log"
+	"fmt"
 
 	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 
 	"github.com/bwmarrin/discordgo"
 )
 
-func (h *Handler) handleStandingsCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	var year int
-	for _, opt := range options {
-		if opt.Name == "year" {
-			year = int(opt.FloatValue())
-			break
-		}
+func (h *Handler) standingsCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameStandings,
+			Description: "Get the standings for a specific year",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year to get standings for",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		handle: h.handleStandingsCommand,
+	}
+}
+
+func (h *Handler) handleStandingsCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	year, _ := opts.Int("year")
+
+	embed, components, err := h.standingsPage(ctx, year, standingsViewFinal)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get the standings.", err)
+		return
+	}
+
+	h.respondPage(s, i, embed, components)
+}
+
+// handleStandingsPage handles the standings buttons, replacing the message with the page encoded in the custom ID
+func (h *Handler) handleStandingsPage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	year, view, err := parseStandingsCustomID(customID)
+	if err != nil {
+		h.respondPageError(s, i, "Hmm... I couldn't read that button.", err)
+		return
+	}
+
+	embed, components, err := h.standingsPage(ctx, year, view)
+	if err != nil {
+		h.respondPageError(s, i, "Hmm... I couldn't get the standings.", err)
+		return
 	}
 
+	h.respondPage(s, i, embed, components)
+}
+
+// standingsPage builds the standings embed and navigation buttons for a year (or the latest league if year is 0)
+func (h *Handler) standingsPage(ctx context.Context, year int, view string) (*discordgo.MessageEmbed, []discordgo.MessageComponent, error) {
 	var err error
 	var league domain.League
 	if year != 0 {
@@ -27,23 +67,76 @@ func (h *Handler) handleStandingsCommand(ctx context.Context, s *discordgo.Sessi
 		league, err = h.interactor.GetLatestLeague(ctx)
 	}
 	if err != nil {
-		log.Printf("error getting league: %v", err)
-		h.Respond(s, i, "Hmm... I couldn't get the league.")
-		return
+		return nil, nil, fmt.Errorf("failed to get league: %w", err)
+	}
+
+	// Only a completed league has final standings that differ from the regular season standings
+	if league.Status != domain.LeagueStatusComplete {
+		view = standingsViewFinal
 	}
 
-	standings, err := h.interactor.GetStandingsForLeague(ctx, league)
+	var standings domain.Standings
+	if view == standingsViewRegular {
+		standings, err = h.interactor.GetRegularSeasonStandingsForLeague(ctx, league)
+	} else {
+		standings, err = h.interactor.GetStandingsForLeague(ctx, league)
+	}
 	if err != nil {
-		log.Printf("error getting standings for year [%d]: %v", year, err)
-		h.Respond(s, i, "Hmm... I couldn't get the standings.")
-		return
+		return nil, nil, fmt.Errorf("failed to get standings: %w", err)
 	}
 
-	users, err := h.interactor.GetUsers(ctx)
+	users, err := h.interactor.GetManagerNames(ctx)
 	if err != nil {
-		log.Printf("error getting users: %v", err)
-		h.Respond(s, i, "Hmm... I couldn't get users.")
-		return
+		return nil, nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	var avatarURL string
+	if len(standings) > 0 {
+		avatarURL = h.avatarURL(ctx, standings[0].UserID)
+	}
+
+	embed := standingsEmbed(league, standings, users, avatarURL, view)
+	return embed, standingsComponents(h.leagueYears(ctx), league, view), nil
+}
+
+// standingsEmbed renders the standings as an embed with one field per team
+func standingsEmbed(league domain.League, standings domain.Standings, users domain.UserMap, avatarURL string, view string) *discordgo.MessageEmbed {
+	final := league.Status == domain.LeagueStatusComplete && view != standingsViewRegular
+
+	title := fmt.Sprintf("🏆 %d Standings 🏆", league.Year)
+	if final {
+		title = fmt.Sprintf("🏆 %d Final Standings 🏆", league.Year)
+	} else if league.Status == domain.LeagueStatusComplete {
+		title = fmt.Sprintf("🏆 %d Regular Season Standings 🏆", league.Year)
 	}
-	h.Respond(s, i, standings.ToDiscordMessage(league, users))
+
+	e := withThumbnail(newEmbed(title), avatarURL)
+	if final {
+		e.Color = embedColorGold
+	}
+
+	medals := []string{"🥇", "🥈", "🥉"}
+	for idx, st := range standings {
+		// Unless the standings are final, mark the playoff line after the top 6 teams
+		if !final && idx == 6 {
+			e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: blankFieldName, Value: "────────── **Playoffs** ──────────"})
+		}
+
+		rank := fmt.Sprintf("%d.", idx+1)
+		if idx < len(medals) {
+			rank = medals[idx]
+		}
+
+		name := users[st.UserID].Name
+		if name == "" {
+			name = st.UserID // Fallback if no name
+		}
+
+		e.Fields = append(e.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("%s %s", rank, name),
+			Value: fmt.Sprintf("%d-%d-%d • PF: %.1f • PA: %.1f", st.Wins, st.Losses, st.Ties, st.PointsFor, st.PointsAgainst),
+		})
+	}
+
+	return e
 }
@@ -0,0 +1,102 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+func (h *Handler) tradesCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameTrades,
+			Description: "Grade a season's trades on how the players each side received have scored since",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year to grade trades for (defaults to the latest league)",
+					Required:     false,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "Only show trades this user was part of",
+					Required:    false,
+				},
+			},
+		},
+		handle: h.handleTradesCommand,
+	}
+}
+
+// handleTradesCommand handles the /trades Discord command, listing a season's trades with their retrospective winners
+func (h *Handler) handleTradesCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	targetUser := opts.User(s, "user")
+
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	title := fmt.Sprintf("%d Trades 🔄", year)
+	var grades domain.TradeGrades
+	var err error
+	if targetUser == nil {
+		grades, err = h.interactor.GetTradeGrades(ctx, year)
+	} else {
+		title = fmt.Sprintf("%s's %d Trades 🔄", targetUser.Username, year)
+		grades, err = h.interactor.GetTradeGradesForDiscordUser(ctx, targetUser.ID, year)
+	}
+	if err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't get trades for %d.", year), err)
+		return
+	}
+
+	users, err := h.interactor.GetManagerNames(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get users.", err)
+		return
+	}
+
+	h.RespondEmbeds(s, i, tradesEmbed(title, grades, users))
+}
+
+// tradesEmbed renders graded trades as an embed with one field per trade, oldest first
+func tradesEmbed(title string, grades domain.TradeGrades, users domain.UserMap) *discordgo.MessageEmbed {
+	e := newEmbed(title)
+	if len(grades) == 0 {
+		e.Description = "No trades have been made."
+		return e
+	}
+
+	e.Description = "Each side is graded on the points its new players have scored in its starting lineup since the trade. Draft picks aren't counted."
+	for _, g := range grades {
+		var b strings.Builder
+		for _, side := range g.Sides {
+			fmt.Fprintf(&b, "**%s** (%s, %.2f pts): %s\n", managerName(users, side.UserID), side.Grade, side.Points, side.Received())
+		}
+		if winner, ok := g.Winner(); ok {
+			fmt.Fprintf(&b, "🏆 %s won by %.2f", managerName(users, winner.UserID), g.Margin())
+		} else {
+			b.WriteString("🤝 Even so far")
+		}
+		e.Fields = append(e.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("Week %d", g.Trade.Week),
+			Value: b.String(),
+		})
+	}
+	return e
+}
+
+// managerName returns a manager's name, falling back to their Sleeper user ID if they're unknown
+func managerName(users domain.UserMap, userID string) string {
+	if user, ok := users[userID]; ok && user.Name != "" {
+		return user.Name
+	}
+	return userID
+}
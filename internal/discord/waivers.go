@@ -0,0 +1,81 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+func (h *Handler) waiversCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameWaivers,
+			Description: "Show FAAB spent, roster moves and the best and most expensive pickups of a season",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year to show waiver activity for (defaults to the latest league)",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		handle: h.handleWaiversCommand,
+	}
+}
+
+// handleWaiversCommand handles the /waivers Discord command, summarizing a season's waiver wire activity
+func (h *Handler) handleWaiversCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
+	year, ok := h.yearOrLatest(ctx, s, i, opts)
+	if !ok {
+		return
+	}
+
+	report, err := h.interactor.GetWaiverReport(ctx, year)
+	if err != nil {
+		h.RespondError(s, i, fmt.Sprintf("Hmm... I couldn't get waiver activity for %d.", year), err)
+		return
+	}
+
+	users, err := h.interactor.GetManagerNames(ctx)
+	if err != nil {
+		h.RespondError(s, i, "Hmm... I couldn't get users.", err)
+		return
+	}
+
+	h.RespondEmbeds(s, i, waiversEmbed(report, users))
+}
+
+// waiversEmbed renders a waiver report as an embed with the standout pickups up top and a field of each manager's activity
+func waiversEmbed(report domain.WaiverReport, users domain.UserMap) *discordgo.MessageEmbed {
+	e := newEmbed(fmt.Sprintf("%d Waiver Wire 📋", report.Year))
+
+	var b strings.Builder
+	if p := report.MostExpensive; p != nil {
+		fmt.Fprintf(&b, "💸 **Most Expensive Pickup**: %s - %s for $%d (Week %d)\n", managerName(users, p.UserID), p.Player.Description(), p.Bid, p.Week)
+	}
+	if p := report.BestPickup; p != nil {
+		fmt.Fprintf(&b, "🦸 **Best Pickup**: %s - %s, %.2f points as a starter since Week %d\n", managerName(users, p.UserID), p.Player.Description(), p.Points, p.Week)
+	}
+	if b.Len() == 0 {
+		b.WriteString("No pickups have been recorded yet.")
+	}
+	e.Description = b.String()
+
+	if len(report.Managers) > 0 {
+		var managers strings.Builder
+		for _, m := range report.Managers {
+			fmt.Fprintf(&managers, "**%s**: ", managerName(users, m.UserID))
+			if m.FAABBudget > 0 {
+				fmt.Fprintf(&managers, "$%d of $%d FAAB, ", m.FAABSpent, m.FAABBudget)
+			}
+			fmt.Fprintf(&managers, "%d pickups, %d moves\n", m.Pickups, m.Moves)
+		}
+		e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: "📊 Activity", Value: managers.String()})
+	}
+	return e
+}
@@ -7,23 +7,39 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/sam-maryland/any-given-sunday/internal/format"
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 )
 
+func (h *Handler) weeklySummaryCommand() command {
+	return command{
+		definition: &discordgo.ApplicationCommand{
+			Name:        commandNameWeeklySummary,
+			Description: "Generate weekly summary with high score winner and updated standings",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionInteger,
+					Name:         "year",
+					Description:  "The year to generate summary for",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		handle: h.handleWeeklySummaryCommand,
+	}
+}
+
 // handleWeeklySummaryCommand handles the /weekly-summary Discord command
-func (h *Handler) handleWeeklySummaryCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+func (h *Handler) handleWeeklySummaryCommand(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, opts commandOptions) {
 	// Extract year from command options, default to current year from latest league
-	var year int
-	options := i.ApplicationCommandData().Options
-	if len(options) > 0 && options[0].Name == "year" {
-		year = int(options[0].IntValue())
-	}
+	year, _ := opts.Int("year")
 
 	// If no year specified, get the latest league
 	if year == 0 {
 		league, err := h.interactor.GetLatestLeague(ctx)
 		if err != nil {
-			h.Respond(s, i, "❌ Failed to get latest league")
-			log.Printf("Failed to get latest league: %v", err)
+			h.RespondError(s, i, "❌ Failed to get latest league", err)
 			return
 		}
 		year = league.Year
@@ -34,25 +50,67 @@ func (h *Handler) handleWeeklySummaryCommand(ctx context.Context, s *discordgo.S
 
 	// Generate weekly summary using shared logic
 	log.Printf("Generating weekly summary for year %d (Discord command)", year)
-	summary, err := h.interactor.GenerateWeeklySummary(ctx, year)
+	embed, components, err := h.weeklySummaryPage(ctx, year, 0)
 	if err != nil {
-		h.Respond(s, i, fmt.Sprintf("❌ Failed to generate weekly summary: %v", err))
-		log.Printf("Failed to generate weekly summary: %v", err)
+		h.RespondError(s, i, fmt.Sprintf("❌ Failed to generate weekly summary: %v", err), err)
 		return
 	}
 
-	// Get users for name lookup
-	users, err := h.interactor.GetUsers(ctx)
+	// Send the response
+	h.respondPage(s, i, embed, components)
+	log.Printf("Successfully sent weekly summary for year %d", year)
+}
+
+// handleWeeklySummaryPage handles the weekly summary buttons, replacing the message with the week encoded in the custom ID
+func (h *Handler) handleWeeklySummaryPage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	year, week, err := parseWeeklySummaryCustomID(customID)
 	if err != nil {
-		h.Respond(s, i, "❌ Failed to get users")
-		log.Printf("Failed to get users: %v", err)
+		h.respondPageError(s, i, "Hmm... I couldn't read that button.", err)
 		return
 	}
 
-	// Use shared formatting logic
-	response := format.WeeklySummary(summary, users)
+	embed, components, err := h.weeklySummaryPage(ctx, year, week)
+	if err != nil {
+		h.respondPageError(s, i, "Hmm... I couldn't get that weekly summary.", err)
+		return
+	}
 
-	// Send the response
-	h.Respond(s, i, response)
-	log.Printf("Successfully sent weekly summary for year %d, week %d", summary.Year, summary.Week)
+	h.respondPage(s, i, embed, components)
+}
+
+// weeklySummaryPage builds the weekly summary embed and navigation buttons for a week (or the latest completed week if week is 0)
+func (h *Handler) weeklySummaryPage(ctx context.Context, year, week int) (*discordgo.MessageEmbed, []discordgo.MessageComponent, error) {
+	summary, err := h.interactor.GenerateWeeklySummaryForWeek(ctx, year, week)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Get users for name lookup
+	users, err := h.interactor.GetManagerNames(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	var avatarURL string
+	if summary.HighScore != nil {
+		avatarURL = h.avatarURL(ctx, summary.HighScore.UserID)
+	}
+
+	return weeklySummaryEmbed(summary, users, avatarURL), weeklySummaryComponents(h.leagueYears(ctx), summary), nil
+}
+
+// weeklySummaryEmbed renders the weekly summary as an embed using the shared formatting logic for each section
+func weeklySummaryEmbed(summary *interactor.WeeklySummary, users domain.UserMap, avatarURL string) *discordgo.MessageEmbed {
+	e := withThumbnail(newEmbed(fmt.Sprintf("📊 Week %d Summary (%d) 📊", summary.Week, summary.Year)), avatarURL)
+	e.Footer.Text = format.WeeklyFooter(summary)
+
+	e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: "💰 High Score", Value: format.WeeklyHighScore(summary)})
+	if len(summary.Awards) > 0 {
+		e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: "🎖️ Weekly Awards", Value: format.WeeklyAwards(summary, users)})
+	}
+	if len(summary.Standings) > 0 {
+		e.Fields = append(e.Fields, &discordgo.MessageEmbedField{Name: "📈 Current Standings", Value: format.WeeklyStandings(summary, users)})
+	}
+
+	return e
 }
@@ -0,0 +1,363 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// welcomeThreadArchiveDuration is how many minutes a private welcome thread stays open without activity
+const welcomeThreadArchiveDuration = 7 * 24 * 60
+
+// Discord returns at most guildMembersPageSize members and channelMessagesPageSize messages per request
+const (
+	guildMembersPageSize    = 1000
+	channelMessagesPageSize = 100
+)
+
+// legacyWelcomePattern matches the public welcome messages the bot used to post in the welcome channel,
+// before welcome messages were sent privately
+var legacyWelcomePattern = regexp.MustCompile(`^<@\d+> \*\*Welcome to the `)
+
+// WelcomeMessages keeps the welcome messages sent to new members up to date: it refreshes the Sleeper accounts
+// they offer as accounts are claimed, cleans them up once their member links an account or leaves the server,
+// and reminds members who haven't linked an account yet.
+type WelcomeMessages struct {
+	session          dependency.IDiscordSession
+	interactor       interactor.OnboardingInteractor
+	guildID          string
+	welcomeChannelID string
+}
+
+// NewWelcomeMessages creates a new welcome message manager
+func NewWelcomeMessages(session dependency.IDiscordSession, interactor interactor.OnboardingInteractor, guildID, welcomeChannelID string) *WelcomeMessages {
+	return &WelcomeMessages{
+		session:          session,
+		interactor:       interactor,
+		guildID:          guildID,
+		welcomeChannelID: welcomeChannelID,
+	}
+}
+
+// Send sends a member the onboarding welcome message with Sleeper user selection and tracks it.
+// It is sent privately so only the member can see and use it.
+func (w *WelcomeMessages) Send(ctx context.Context, user *discordgo.User) error {
+	availableUsers, err := w.interactor.GetAvailableSleeperUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get available Sleeper users: %w", err)
+	}
+
+	content, components := welcomeMessage(user.ID, availableUsers, 0)
+
+	message, err := w.sendPrivately(user, &discordgo.MessageSend{
+		Content:    content,
+		Components: components,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send welcome message: %w", err)
+	}
+
+	log.Printf("Sent welcome message to user %s (%s) with %d available Sleeper accounts",
+		user.Username, user.ID, len(availableUsers))
+
+	// The member can still link their account without tracking, so errors are only logged
+	if err := w.interactor.RecordWelcomeMessage(ctx, user.ID, message.ChannelID, message.ID); err != nil {
+		log.Printf("⚠️ %v", err)
+	}
+	return nil
+}
+
+// sendPrivately sends a message to a member by DM. Members who don't accept DMs from the server get it in a
+// private thread in the welcome channel instead, which only they, moderators and the bot can see.
+func (w *WelcomeMessages) sendPrivately(user *discordgo.User, data *discordgo.MessageSend) (*discordgo.Message, error) {
+	channel, err := w.session.UserChannelCreate(user.ID)
+	if err == nil {
+		var message *discordgo.Message
+		if message, err = w.session.ChannelMessageSendComplex(channel.ID, data); err == nil {
+			return message, nil
+		}
+	}
+	log.Printf("Couldn't DM user %s (%s), using a private thread instead: %v", user.Username, user.ID, err)
+
+	thread, err := w.session.ThreadStartComplex(w.welcomeChannelID, &discordgo.ThreadStart{
+		Name:                fmt.Sprintf("Welcome %s", user.Username),
+		Type:                discordgo.ChannelTypeGuildPrivateThread,
+		AutoArchiveDuration: welcomeThreadArchiveDuration,
+		Invitable:           false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private thread: %w", err)
+	}
+
+	if err := w.session.ThreadMemberAdd(thread.ID, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to add user to private thread: %w", err)
+	}
+
+	return w.session.ChannelMessageSendComplex(thread.ID, data)
+}
+
+// Close replaces a member's welcome message with a note that they have linked their account and stops tracking it.
+// Members without a welcome message are ignored.
+func (w *WelcomeMessages) Close(ctx context.Context, discordID string) error {
+	message, err := w.interactor.RemoveWelcomeMessage(ctx, discordID)
+	if err != nil || message == nil {
+		return err
+	}
+
+	content := "✅ You've linked your Sleeper account. Welcome to the league!"
+	if err := w.edit(*message, content, []discordgo.MessageComponent{}); err != nil && !isUnknownMessage(err) {
+		return fmt.Errorf("failed to close welcome message for Discord user %s: %w", discordID, err)
+	}
+	return nil
+}
+
+// Refresh updates every open welcome message with the Sleeper accounts that are still available,
+// so members can't pick an account someone else claimed in the meantime. Messages go back to their first page.
+func (w *WelcomeMessages) Refresh(ctx context.Context) error {
+	messages, err := w.interactor.GetWelcomeMessages(ctx)
+	if err != nil || len(messages) == 0 {
+		return err
+	}
+
+	availableUsers, err := w.interactor.GetAvailableSleeperUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get available Sleeper users: %w", err)
+	}
+
+	for _, message := range messages {
+		content, components := welcomeMessage(message.DiscordID, availableUsers, 0)
+		err := w.edit(message, content, components)
+		if isUnknownMessage(err) {
+			// Someone deleted the message, so there is nothing left to refresh
+			w.forget(ctx, message.DiscordID)
+			continue
+		}
+		if err != nil {
+			log.Printf("⚠️ Failed to refresh welcome message for Discord user %s: %v", message.DiscordID, err)
+		}
+	}
+
+	log.Printf("Refreshed %d welcome messages with %d available Sleeper accounts", len(messages), len(availableUsers))
+	return nil
+}
+
+// SendReminders DMs every member who still hasn't linked their account once a reminder is due.
+// Members who never got a private welcome message, e.g. because they joined before welcome messages were tracked,
+// are sent one first, and the public welcome messages posted before then are deleted. Welcome messages of members
+// who have since linked their account or left the server are cleaned up instead.
+func (w *WelcomeMessages) SendReminders(ctx context.Context, now time.Time) error {
+	messages, err := w.interactor.GetWelcomeMessages(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := w.welcomeUntrackedMembers(ctx, messages); err != nil {
+		return err
+	}
+
+	if err := w.deleteLegacyWelcomes(); err != nil {
+		log.Printf("⚠️ Failed to delete public welcome messages: %v", err)
+	}
+
+	var reminded int
+	for _, message := range messages {
+		if _, err := w.interactor.GetLinkedUser(ctx, message.DiscordID); err == nil {
+			if err := w.Close(ctx, message.DiscordID); err != nil {
+				log.Printf("⚠️ %v", err)
+			}
+			continue
+		}
+
+		if _, err := w.session.GuildMember(w.guildID, message.DiscordID); isUnknownMember(err) {
+			w.removeLeftMember(ctx, message)
+			continue
+		}
+
+		if !message.ReminderDue(now) {
+			continue
+		}
+
+		if err := w.remind(ctx, message); err != nil {
+			log.Printf("⚠️ Failed to remind Discord user %s to link their account: %v", message.DiscordID, err)
+			continue
+		}
+		reminded++
+	}
+
+	log.Printf("✅ Sent %d onboarding reminders", reminded)
+	return nil
+}
+
+// welcomeUntrackedMembers sends a private welcome message to every member of the server who hasn't linked a
+// Sleeper account and has no tracked welcome message. Members who linked an account but were never set up
+// in Discord are left to the retry button they were shown when linking.
+func (w *WelcomeMessages) welcomeUntrackedMembers(ctx context.Context, messages domain.WelcomeMessages) error {
+	tracked := make(map[string]bool, len(messages))
+	for _, message := range messages {
+		tracked[message.DiscordID] = true
+	}
+
+	members, err := w.guildMembers()
+	if err != nil {
+		return fmt.Errorf("failed to list server members: %w", err)
+	}
+
+	var welcomed int
+	for _, member := range members {
+		if member.User == nil || member.User.Bot || tracked[member.User.ID] {
+			continue
+		}
+
+		onboarded, err := w.interactor.IsUserOnboarded(ctx, member.User.ID)
+		if err != nil {
+			log.Printf("⚠️ Error checking onboarding status for user %s: %v", member.User.ID, err)
+			continue
+		}
+		if onboarded {
+			continue
+		}
+		if _, err := w.interactor.GetLinkedUser(ctx, member.User.ID); err == nil {
+			log.Printf("User %s (%s) linked their account but isn't set up in Discord yet", member.User.Username, member.User.ID)
+			continue
+		}
+
+		if err := w.Send(ctx, member.User); err != nil {
+			log.Printf("⚠️ Error sending welcome message to user %s: %v", member.User.ID, err)
+			continue
+		}
+		welcomed++
+	}
+
+	log.Printf("✅ Sent %d welcome messages to members without one", welcomed)
+	return nil
+}
+
+// guildMembers lists every member of the server, a page at a time
+func (w *WelcomeMessages) guildMembers() ([]*discordgo.Member, error) {
+	var members []*discordgo.Member
+	after := ""
+	for {
+		page, err := w.session.GuildMembers(w.guildID, after, guildMembersPageSize)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		if len(page) < guildMembersPageSize {
+			return members, nil
+		}
+		after = page[len(page)-1].User.ID
+	}
+}
+
+// deleteLegacyWelcomes deletes the public welcome messages the bot posted in the welcome channel before welcome
+// messages were sent privately. Their members have either linked an account or been sent a private one.
+func (w *WelcomeMessages) deleteLegacyWelcomes() error {
+	if w.welcomeChannelID == "" {
+		return nil
+	}
+
+	var deleted int
+	before := ""
+	for {
+		page, err := w.session.ChannelMessages(w.welcomeChannelID, channelMessagesPageSize, before, "", "")
+		if err != nil {
+			return err
+		}
+
+		for _, message := range page {
+			if message.Author == nil || !message.Author.Bot || !legacyWelcomePattern.MatchString(message.Content) {
+				continue
+			}
+			if err := w.session.ChannelMessageDelete(w.welcomeChannelID, message.ID); err != nil && !isUnknownMessage(err) {
+				log.Printf("⚠️ Failed to delete public welcome message %s: %v", message.ID, err)
+				continue
+			}
+			deleted++
+		}
+
+		if len(page) < channelMessagesPageSize {
+			break
+		}
+		// Messages come newest first, so the next page is older than the last message
+		before = page[len(page)-1].ID
+	}
+
+	if deleted > 0 {
+		log.Printf("Deleted %d public welcome messages", deleted)
+	}
+	return nil
+}
+
+func (w *WelcomeMessages) remind(ctx context.Context, message domain.WelcomeMessage) error {
+	channel, err := w.session.UserChannelCreate(message.DiscordID)
+	if err != nil {
+		return fmt.Errorf("failed to open DM channel: %w", err)
+	}
+
+	if _, err := w.session.ChannelMessageSend(channel.ID, onboardingReminderMessage(message, w.guildID, channel.ID)); err != nil {
+		return fmt.Errorf("failed to send DM: %w", err)
+	}
+
+	return w.interactor.RecordOnboardingReminder(ctx, message.DiscordID)
+}
+
+// removeLeftMember deletes the welcome message of a member who left the server without linking an account
+func (w *WelcomeMessages) removeLeftMember(ctx context.Context, message domain.WelcomeMessage) {
+	if err := w.session.ChannelMessageDelete(message.ChannelID, message.MessageID); err != nil && !isUnknownMessage(err) {
+		log.Printf("⚠️ Failed to delete welcome message for Discord user %s: %v", message.DiscordID, err)
+		return
+	}
+	w.forget(ctx, message.DiscordID)
+	log.Printf("Removed welcome message for Discord user %s, who left the server", message.DiscordID)
+}
+
+func (w *WelcomeMessages) forget(ctx context.Context, discordID string) {
+	if _, err := w.interactor.RemoveWelcomeMessage(ctx, discordID); err != nil {
+		log.Printf("⚠️ %v", err)
+	}
+}
+
+func (w *WelcomeMessages) edit(message domain.WelcomeMessage, content string, components []discordgo.MessageComponent) error {
+	_, err := w.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         message.MessageID,
+		Channel:    message.ChannelID,
+		Content:    &content,
+		Components: &components,
+	})
+	return err
+}
+
+// onboardingReminderMessage formats the Discord DM sent to a member who hasn't linked their Sleeper account.
+// Welcome messages sent by DM are right above the reminder, while those in a private thread are linked to.
+func onboardingReminderMessage(message domain.WelcomeMessage, guildID, dmChannelID string) string {
+	welcome := fmt.Sprintf("your welcome message (%s)", message.URL(guildID))
+	if message.ChannelID == dmChannelID {
+		welcome = "my welcome message above"
+	}
+
+	return fmt.Sprintf("👋 Hey! You haven't linked your Sleeper account in the Any Given Sunday Discord yet. "+
+		"Pick your account from %s or use `/link` in the server to unlock the bot commands. 🏈", welcome)
+}
+
+func isUnknownMessage(err error) bool {
+	return isRESTError(err, discordgo.ErrCodeUnknownMessage)
+}
+
+func isUnknownMember(err error) bool {
+	return isRESTError(err, discordgo.ErrCodeUnknownMember)
+}
+
+// isRESTError reports whether err is a Discord API error with the given JSON error code
+func isRESTError(err error, code int) bool {
+	var restErr *discordgo.RESTError
+	return errors.As(err, &restErr) && restErr.Message != nil && restErr.Message.Code == code
+}
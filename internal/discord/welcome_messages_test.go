@@ -0,0 +1,346 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func restError(code int) error {
+	return &discordgo.RESTError{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+		Message:  &discordgo.APIErrorMessage{Code: code},
+	}
+}
+
+func TestWelcomeMessages_Send(t *testing.T) {
+	user := &discordgo.User{ID: "discord1", Username: "newguy"}
+	onboarding := &mockOnboardingInteractor{
+		GetAvailableSleeperUsersFunc: func(ctx context.Context) ([]interactor.AvailableSleeperUser, error) {
+			return []interactor.AvailableSleeperUser{{SleeperUserID: "sleeper1", DisplayName: "John Doe", Username: "johndoe"}}, nil
+		},
+	}
+
+	t.Run("sends the welcome message by DM", func(t *testing.T) {
+		var channelID string
+		var recorded []string
+		session := &dependency.MockDiscordSession{
+			ChannelMessageSendComplexFunc: func(id string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+				channelID = id
+				assert.Equal(t, "sleeper_user_select:discord1", welcomeSelectMenu(t, data.Components).CustomID)
+				return &discordgo.Message{ID: "message1", ChannelID: id}, nil
+			},
+			ThreadStartComplexFunc: func(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+				t.Fatal("no thread should be started")
+				return nil, nil
+			},
+		}
+		onboarding.RecordWelcomeMessageFunc = func(ctx context.Context, discordID, channelID, messageID string) error {
+			recorded = []string{discordID, channelID, messageID}
+			return nil
+		}
+		require.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").Send(context.Background(), user))
+		assert.Equal(t, "dm-discord1", channelID)
+		assert.Equal(t, []string{"discord1", "dm-discord1", "message1"}, recorded)
+	})
+
+	t.Run("falls back to a private thread when DMs are closed", func(t *testing.T) {
+		var thread *discordgo.ThreadStart
+		var added, recorded []string
+		session := &dependency.MockDiscordSession{
+			ChannelMessageSendComplexFunc: func(id string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+				if id == "dm-discord1" {
+					return nil, restError(discordgo.ErrCodeCannotSendMessagesToThisUser)
+				}
+				return &discordgo.Message{ID: "message1", ChannelID: id}, nil
+			},
+			ThreadStartComplexFunc: func(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+				assert.Equal(t, "welcome", channelID)
+				thread = data
+				return &discordgo.Channel{ID: "thread1"}, nil
+			},
+			ThreadMemberAddFunc: func(threadID, memberID string, options ...discordgo.RequestOption) error {
+				added = []string{threadID, memberID}
+				return nil
+			},
+		}
+		onboarding.RecordWelcomeMessageFunc = func(ctx context.Context, discordID, channelID, messageID string) error {
+			recorded = []string{discordID, channelID, messageID}
+			return nil
+		}
+		require.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").Send(context.Background(), user))
+		require.NotNil(t, thread)
+		assert.Equal(t, discordgo.ChannelTypeGuildPrivateThread, thread.Type)
+		assert.False(t, thread.Invitable)
+		assert.Equal(t, "Welcome newguy", thread.Name)
+		assert.Equal(t, []string{"thread1", "discord1"}, added)
+		assert.Equal(t, []string{"discord1", "thread1", "message1"}, recorded)
+	})
+
+	t.Run("fails when the thread can't be started either", func(t *testing.T) {
+		session := &dependency.MockDiscordSession{
+			UserChannelCreateFunc: func(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+				return nil, errors.New("DMs are closed")
+			},
+			ThreadStartComplexFunc: func(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+				return nil, errors.New("missing permissions")
+			},
+		}
+		assert.ErrorContains(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").Send(context.Background(), user), "failed to start private thread")
+	})
+}
+
+func TestWelcomeMessages_Close(t *testing.T) {
+	t.Run("replaces the welcome message and stops tracking it", func(t *testing.T) {
+		var edit *discordgo.MessageEdit
+		session := &dependency.MockDiscordSession{
+			ChannelMessageEditComplexFunc: func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+				edit = m
+				return &discordgo.Message{}, nil
+			},
+		}
+		onboarding := &mockOnboardingInteractor{
+			RemoveWelcomeMessageFunc: func(ctx context.Context, discordID string) (*domain.WelcomeMessage, error) {
+				assert.Equal(t, "discord1", discordID)
+				return &domain.WelcomeMessage{DiscordID: discordID, ChannelID: "welcome", MessageID: "message1"}, nil
+			},
+		}
+
+		require.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").Close(context.Background(), "discord1"))
+		require.NotNil(t, edit)
+		assert.Equal(t, "welcome", edit.Channel)
+		assert.Equal(t, "message1", edit.ID)
+		assert.Equal(t, "✅ You've linked your Sleeper account. Welcome to the league!", *edit.Content)
+		assert.Empty(t, *edit.Components)
+	})
+
+	t.Run("ignores members without a welcome message", func(t *testing.T) {
+		session := &dependency.MockDiscordSession{
+			ChannelMessageEditComplexFunc: func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+				t.Fatal("no message should be edited")
+				return nil, nil
+			},
+		}
+
+		assert.NoError(t, NewWelcomeMessages(session, &mockOnboardingInteractor{}, "guild", "welcome").Close(context.Background(), "discord1"))
+	})
+
+	t.Run("ignores deleted welcome messages", func(t *testing.T) {
+		session := &dependency.MockDiscordSession{
+			ChannelMessageEditComplexFunc: func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+				return nil, restError(discordgo.ErrCodeUnknownMessage)
+			},
+		}
+		onboarding := &mockOnboardingInteractor{
+			RemoveWelcomeMessageFunc: func(ctx context.Context, discordID string) (*domain.WelcomeMessage, error) {
+				return &domain.WelcomeMessage{DiscordID: discordID}, nil
+			},
+		}
+
+		assert.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").Close(context.Background(), "discord1"))
+	})
+}
+
+func TestWelcomeMessages_Refresh(t *testing.T) {
+	edits := map[string]*discordgo.MessageEdit{}
+	var forgotten []string
+	session := &dependency.MockDiscordSession{
+		ChannelMessageEditComplexFunc: func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+			if m.ID == "deleted" {
+				return nil, restError(discordgo.ErrCodeUnknownMessage)
+			}
+			edits[m.ID] = m
+			return &discordgo.Message{}, nil
+		},
+	}
+	onboarding := &mockOnboardingInteractor{
+		GetWelcomeMessagesFunc: func(ctx context.Context) (domain.WelcomeMessages, error) {
+			return domain.WelcomeMessages{
+				{DiscordID: "discord1", ChannelID: "welcome", MessageID: "message1"},
+				{DiscordID: "discord2", ChannelID: "welcome", MessageID: "deleted"},
+			}, nil
+		},
+		GetAvailableSleeperUsersFunc: func(ctx context.Context) ([]interactor.AvailableSleeperUser, error) {
+			return []interactor.AvailableSleeperUser{{SleeperUserID: "sleeper2", DisplayName: "Jane Smith", Username: "janesmith"}}, nil
+		},
+		RemoveWelcomeMessageFunc: func(ctx context.Context, discordID string) (*domain.WelcomeMessage, error) {
+			forgotten = append(forgotten, discordID)
+			return nil, nil
+		},
+	}
+
+	require.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").Refresh(context.Background()))
+
+	require.Contains(t, edits, "message1")
+	components := *edits["message1"].Components
+	require.Len(t, components, 1)
+	menu := components[0].(discordgo.ActionsRow).Components[0].(*discordgo.SelectMenu)
+	require.Len(t, menu.Options, 1)
+	assert.Equal(t, "sleeper2", menu.Options[0].Value)
+	assert.Equal(t, []string{"discord2"}, forgotten)
+}
+
+func TestWelcomeMessages_Refresh_NoAccountsLeft(t *testing.T) {
+	var edit *discordgo.MessageEdit
+	session := &dependency.MockDiscordSession{
+		ChannelMessageEditComplexFunc: func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+			edit = m
+			return &discordgo.Message{}, nil
+		},
+	}
+	onboarding := &mockOnboardingInteractor{
+		GetWelcomeMessagesFunc: func(ctx context.Context) (domain.WelcomeMessages, error) {
+			return domain.WelcomeMessages{{DiscordID: "discord1", ChannelID: "welcome", MessageID: "message1"}}, nil
+		},
+	}
+
+	require.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").Refresh(context.Background()))
+	require.NotNil(t, edit)
+	assert.Contains(t, *edit.Content, "all Sleeper accounts have already been claimed")
+	assert.Empty(t, *edit.Components)
+}
+
+func TestWelcomeMessages_SendReminders(t *testing.T) {
+	now := time.Date(2024, 9, 10, 17, 0, 0, 0, time.UTC)
+	messages := domain.WelcomeMessages{
+		{DiscordID: "linked", ChannelID: "welcome", MessageID: "m1", CreatedAt: now.Add(-72 * time.Hour)},
+		{DiscordID: "left", ChannelID: "welcome", MessageID: "m2", CreatedAt: now.Add(-72 * time.Hour)},
+		{DiscordID: "due", ChannelID: "welcome", MessageID: "m3", CreatedAt: now.Add(-72 * time.Hour)},
+		{DiscordID: "new", ChannelID: "welcome", MessageID: "m4", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	var edited, deleted, dms, reminded, removed []string
+	session := &dependency.MockDiscordSession{
+		GuildMemberFunc: func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error) {
+			if userID == "left" {
+				return nil, restError(discordgo.ErrCodeUnknownMember)
+			}
+			return &discordgo.Member{}, nil
+		},
+		ChannelMessageEditComplexFunc: func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+			edited = append(edited, m.ID)
+			return &discordgo.Message{}, nil
+		},
+		ChannelMessageDeleteFunc: func(channelID, messageID string, options ...discordgo.RequestOption) error {
+			deleted = append(deleted, messageID)
+			return nil
+		},
+		ChannelMessageSendFunc: func(channelID, content string) (*discordgo.Message, error) {
+			dms = append(dms, channelID)
+			assert.Contains(t, content, "https://discord.com/channels/guild/welcome/m3")
+			return &discordgo.Message{}, nil
+		},
+	}
+	onboarding := &mockOnboardingInteractor{
+		GetWelcomeMessagesFunc: func(ctx context.Context) (domain.WelcomeMessages, error) {
+			return messages, nil
+		},
+		GetLinkedUserFunc: func(ctx context.Context, discordID string) (domain.User, error) {
+			if discordID == "linked" {
+				return domain.User{ID: "sleeper1", DiscordID: discordID}, nil
+			}
+			return domain.User{}, interactor.ErrNotLinked
+		},
+		RemoveWelcomeMessageFunc: func(ctx context.Context, discordID string) (*domain.WelcomeMessage, error) {
+			removed = append(removed, discordID)
+			for _, m := range messages {
+				if m.DiscordID == discordID {
+					return &m, nil
+				}
+			}
+			return nil, nil
+		},
+		RecordOnboardingReminderFunc: func(ctx context.Context, discordID string) error {
+			reminded = append(reminded, discordID)
+			return nil
+		},
+	}
+
+	require.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").SendReminders(context.Background(), now))
+
+	assert.Equal(t, []string{"m1"}, edited)
+	assert.Equal(t, []string{"m2"}, deleted)
+	assert.Equal(t, []string{"linked", "left"}, removed)
+	assert.Equal(t, []string{"dm-due"}, dms)
+	assert.Equal(t, []string{"due"}, reminded)
+}
+
+func TestWelcomeMessages_SendReminders_WelcomesUntrackedMembers(t *testing.T) {
+	members := []*discordgo.Member{
+		{User: &discordgo.User{ID: "bot", Bot: true}},
+		{User: &discordgo.User{ID: "tracked"}},
+		{User: &discordgo.User{ID: "onboarded"}},
+		{User: &discordgo.User{ID: "linked"}},
+		{User: &discordgo.User{ID: "untracked", Username: "oldtimer"}},
+	}
+
+	var welcomed, deleted []string
+	session := &dependency.MockDiscordSession{
+		GuildMembersFunc: func(guildID string, after string, limit int, options ...discordgo.RequestOption) ([]*discordgo.Member, error) {
+			assert.Equal(t, "guild", guildID)
+			return members, nil
+		},
+		ChannelMessagesFunc: func(channelID string, limit int, beforeID, afterID, aroundID string, options ...discordgo.RequestOption) ([]*discordgo.Message, error) {
+			assert.Equal(t, "welcome", channelID)
+			return []*discordgo.Message{
+				{ID: "legacy1", Author: &discordgo.User{Bot: true}, Content: "<@123> **Welcome to the Any Given Sunday Discord!** 🏈"},
+				{ID: "legacy2", Author: &discordgo.User{Bot: true}, Content: "<@456> **Welcome to the dynasty league Discord!** 🏈"},
+				{ID: "announcement", Author: &discordgo.User{Bot: true}, Content: welcomeAnnouncement("onboarded", "Team")},
+				{ID: "chat", Author: &discordgo.User{}, Content: "<@123> **Welcome to the league!**"},
+			}, nil
+		},
+		ChannelMessageSendComplexFunc: func(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+			welcomed = append(welcomed, channelID)
+			return &discordgo.Message{ID: "message1", ChannelID: channelID}, nil
+		},
+		ChannelMessageDeleteFunc: func(channelID, messageID string, options ...discordgo.RequestOption) error {
+			assert.Equal(t, "welcome", channelID)
+			deleted = append(deleted, messageID)
+			return nil
+		},
+	}
+
+	var recorded []string
+	onboarding := &mockOnboardingInteractor{
+		GetWelcomeMessagesFunc: func(ctx context.Context) (domain.WelcomeMessages, error) {
+			return domain.WelcomeMessages{{DiscordID: "tracked", ChannelID: "dm-tracked", MessageID: "m1", CreatedAt: time.Now()}}, nil
+		},
+		IsUserOnboardedFunc: func(ctx context.Context, discordID string) (bool, error) {
+			return discordID == "onboarded", nil
+		},
+		GetLinkedUserFunc: func(ctx context.Context, discordID string) (domain.User, error) {
+			if discordID == "linked" {
+				return domain.User{ID: "sleeper1", DiscordID: discordID}, nil
+			}
+			return domain.User{}, interactor.ErrNotLinked
+		},
+		RecordWelcomeMessageFunc: func(ctx context.Context, discordID, channelID, messageID string) error {
+			recorded = append(recorded, discordID)
+			return nil
+		},
+	}
+
+	require.NoError(t, NewWelcomeMessages(session, onboarding, "guild", "welcome").SendReminders(context.Background(), time.Now()))
+
+	assert.Equal(t, []string{"dm-untracked"}, welcomed)
+	assert.Equal(t, []string{"untracked"}, recorded)
+	assert.Equal(t, []string{"legacy1", "legacy2"}, deleted)
+}
+
+func TestOnboardingReminderMessage(t *testing.T) {
+	thread := domain.WelcomeMessage{DiscordID: "discord1", ChannelID: "thread1", MessageID: "message1"}
+	assert.Contains(t, onboardingReminderMessage(thread, "guild", "dm-discord1"), "your welcome message (https://discord.com/channels/guild/thread1/message1)")
+
+	dm := domain.WelcomeMessage{DiscordID: "discord1", ChannelID: "dm-discord1", MessageID: "message1"}
+	assert.Contains(t, onboardingReminderMessage(dm, "guild", "dm-discord1"), "my welcome message above")
+}
@@ -49,6 +49,38 @@ func (c *Client) SendWeeklyRecap(ctx context.Context, summary *interactor.Weekly
 	// Generate subject line
 	subject := fmt.Sprintf("🏈 Any Given Sunday: Week %d Recap", summary.Week)
 
+	return c.sendToRecipients(ctx, recipients, subject, htmlContent)
+}
+
+// SendSeasonAwards sends the end-of-season awards email to users with email addresses
+// recipients: users to send emails to (must have email addresses)
+// teamNames: map of UserID -> User with team names from Sleeper (for display in email)
+func (c *Client) SendSeasonAwards(ctx context.Context, awards *domain.SeasonAwards, recipients []domain.User, teamNames domain.UserMap) error {
+	if len(recipients) == 0 {
+		log.Println("No users with email addresses found, skipping email sending")
+		return nil
+	}
+
+	htmlContent := GenerateSeasonAwardsHTML(awards, teamNames)
+	subject := fmt.Sprintf("🏆 Any Given Sunday: %d Season Awards", awards.Year)
+
+	return c.sendToRecipients(ctx, recipients, subject, htmlContent)
+}
+
+// SendYearInReview sends a manager their personalized year-in-review email
+// teamNames: map of UserID -> User with team names (for display in email)
+func (c *Client) SendYearInReview(ctx context.Context, review domain.YearInReview, recipient domain.User, teamNames domain.UserMap) error {
+	if recipient.Email == "" {
+		return fmt.Errorf("no email address for user %s", recipient.ID)
+	}
+
+	subject := fmt.Sprintf("🎁 Any Given Sunday: Your %d Year in Review", review.Year)
+
+	return c.sendEmail(ctx, recipient.Email, subject, GenerateYearInReviewHTML(review, teamNames))
+}
+
+// sendToRecipients sends the same email to each recipient, returning an error only if every send failed
+func (c *Client) sendToRecipients(ctx context.Context, recipients []domain.User, subject string, htmlContent string) error {
 	// Send email to each recipient
 	// Rate limit: Resend allows 2 requests/second, so we wait 600ms between sends
 	successCount := 0
@@ -71,7 +103,7 @@ func (c *Client) SendWeeklyRecap(ctx context.Context, summary *interactor.Weekly
 			continue
 		}
 
-		log.Printf("Successfully sent weekly recap email to %s (%s)", user.Name, user.Email)
+		log.Printf("Successfully sent email to %s (%s)", user.Name, user.Email)
 		successCount++
 	}
 
@@ -85,6 +117,17 @@ func (c *Client) SendWeeklyRecap(ctx context.Context, summary *interactor.Weekly
 	return nil
 }
 
+// SendDuesReminder emails a member whose buy-in is still outstanding
+func (c *Client) SendDuesReminder(ctx context.Context, status domain.DuesStatus, year int) error {
+	if status.Email == "" {
+		return fmt.Errorf("no email address for user %s", status.UserID)
+	}
+
+	subject := fmt.Sprintf("💸 Any Given Sunday: %d Dues Reminder", year)
+
+	return c.sendEmail(ctx, status.Email, subject, GenerateDuesReminderHTML(status, year))
+}
+
 // sendEmail sends an individual email via Resend
 func (c *Client) sendEmail(_ context.Context, toEmail string, subject string, htmlContent string) error {
 	params := &resend.SendEmailRequest{
@@ -68,6 +68,39 @@ func GenerateWeeklyRecapHTML(summary *interactor.WeeklySummary, users domain.Use
 `)
 	}
 
+	// Weekly Awards Section
+	if len(summary.Awards) > 0 {
+		html.WriteString(`
+                    <!-- Weekly Awards -->
+                    <tr>
+                        <td style="padding: 30px 20px 0 20px;">
+                            <h3 style="color: #0a3d0c; margin: 0 0 20px 0; font-size: 20px; text-align: center; font-weight: bold;">🎖️ WEEKLY AWARDS 🎖️</h3>
+                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
+`)
+
+		for i, award := range summary.Awards {
+			bgColor := "#ffffff"
+			if i%2 == 1 {
+				bgColor = "#f9f9f9"
+			}
+
+			html.WriteString(fmt.Sprintf(`
+                                <tr>
+                                    <td style="padding: 12px 15px; background-color: %s; border-bottom: 1px solid #e0e0e0;">
+                                        <span style="color: #333; font-size: 16px; font-weight: bold;">%s %s</span><br>
+                                        <span style="color: #666; font-size: 14px;">%s</span>
+                                    </td>
+                                </tr>
+`, bgColor, award.Emoji, award.Name, award.Description(users)))
+		}
+
+		html.WriteString(`
+                            </table>
+                        </td>
+                    </tr>
+`)
+	}
+
 	// Standings Section
 	html.WriteString(`
                     <!-- Standings -->
@@ -147,3 +180,372 @@ func getBoldWeight(position int) string {
 	}
 	return "normal"
 }
+
+// GenerateDuesReminderHTML creates the HTML email reminding a member that their buy-in is still outstanding
+func GenerateDuesReminderHTML(status domain.DuesStatus, year int) string {
+	var html strings.Builder
+
+	html.WriteString(`<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Dues Reminder</title>
+</head>
+<body style="margin: 0; padding: 0; font-family: Arial, Helvetica, sans-serif; background-color: #f4f4f4;">
+    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f4f4f4;">
+        <tr>
+            <td align="center" style="padding: 20px 0;">
+                <!-- Main Container -->
+                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; background-color: #ffffff; border-radius: 8px; overflow: hidden; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+`)
+
+	// Header Section
+	html.WriteString(fmt.Sprintf(`
+                    <!-- Header -->
+                    <tr>
+                        <td style="background: linear-gradient(135deg, #0a3d0c 0%%, #1a5d1a 100%%); padding: 30px 20px; text-align: center;">
+                            <h1 style="color: #ffffff; margin: 0; font-size: 28px; font-weight: bold;">ANY GIVEN SUNDAY</h1>
+                            <p style="color: #e0e0e0; margin: 10px 0 0 0; font-size: 18px;">%d Dues Reminder</p>
+                        </td>
+                    </tr>
+`, year))
+
+	// Amount Owed Section
+	html.WriteString(fmt.Sprintf(`
+                    <!-- Amount Owed -->
+                    <tr>
+                        <td style="padding: 30px 20px; text-align: center;">
+                            <p style="color: #333; margin: 0 0 10px 0; font-size: 16px;">Hey %s, our records show your buy-in isn't fully paid yet.</p>
+                            <h2 style="color: #000; margin: 10px 0; font-size: 32px; font-weight: bold;">$%d</h2>
+                            <p style="color: #666; margin: 10px 0 0 0; font-size: 14px;">Paid so far: $%d of $%d</p>
+                        </td>
+                    </tr>
+`, status.UserName, status.Outstanding(), status.AmountPaid, status.AmountDue))
+
+	// Footer Section
+	html.WriteString(`
+                    <!-- Footer -->
+                    <tr>
+                        <td style="background-color: #f8f8f8; padding: 25px 20px; text-align: center; border-top: 2px solid #e0e0e0;">
+                            <p style="color: #555; margin: 0; font-size: 14px;">Please pay the commissioner before the trade deadline. Already paid? Let them know so they can mark it! 💸</p>
+                        </td>
+                    </tr>
+`)
+
+	// Close HTML
+	html.WriteString(`
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>`)
+
+	return html.String()
+}
+
+// GenerateSeasonAwardsHTML creates the end-of-season awards ceremony email
+func GenerateSeasonAwardsHTML(awards *domain.SeasonAwards, users domain.UserMap) string {
+	var html strings.Builder
+
+	html.WriteString(`<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Season Awards</title>
+</head>
+<body style="margin: 0; padding: 0; font-family: Arial, Helvetica, sans-serif; background-color: #f4f4f4;">
+    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f4f4f4;">
+        <tr>
+            <td align="center" style="padding: 20px 0;">
+                <!-- Main Container -->
+                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; background-color: #ffffff; border-radius: 8px; overflow: hidden; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+`)
+
+	// Header Section
+	html.WriteString(fmt.Sprintf(`
+                    <!-- Header -->
+                    <tr>
+                        <td style="background: linear-gradient(135deg, #0a3d0c 0%%, #1a5d1a 100%%); padding: 30px 20px; text-align: center;">
+                            <h1 style="color: #ffffff; margin: 0; font-size: 28px; font-weight: bold;">ANY GIVEN SUNDAY</h1>
+                            <p style="color: #e0e0e0; margin: 10px 0 0 0; font-size: 18px;">%d Season Awards</p>
+                        </td>
+                    </tr>
+`, awards.Year))
+
+	// Champion Section
+	if len(awards.Podium) > 0 {
+		html.WriteString(fmt.Sprintf(`
+                    <!-- Champion -->
+                    <tr>
+                        <td style="background-color: #ffd700; padding: 30px 20px; text-align: center; border-bottom: 4px solid #f0c000;">
+                            <p style="color: #333; margin: 0 0 10px 0; font-size: 16px; font-weight: bold; text-transform: uppercase; letter-spacing: 1px;">🏆 League Champion 🏆</p>
+                            <h2 style="color: #000; margin: 10px 0; font-size: 32px; font-weight: bold;">%s</h2>
+                        </td>
+                    </tr>
+`, displayName(users, awards.Podium[0])))
+	}
+
+	// Podium and Awards Section
+	html.WriteString(`
+                    <!-- Awards -->
+                    <tr>
+                        <td style="padding: 30px 20px;">
+                            <h3 style="color: #0a3d0c; margin: 0 0 20px 0; font-size: 20px; text-align: center; font-weight: bold;">🎖️ AWARDS 🎖️</h3>
+                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
+`)
+
+	var rows [][2]string
+	places := []string{"🥇 Champion", "🥈 Runner-up", "🥉 Third Place"}
+	for place, userID := range awards.Podium {
+		if place >= len(places) {
+			break
+		}
+		rows = append(rows, [2]string{places[place], displayName(users, userID)})
+	}
+	if awards.MVP != nil {
+		rows = append(rows, [2]string{"⭐ Regular Season MVP", fmt.Sprintf("%s (%.2f PF)", displayName(users, awards.MVP.UserID), awards.MVP.Value)})
+	}
+	if awards.Unluckiest != nil {
+		rows = append(rows, [2]string{"🍀 Unluckiest", fmt.Sprintf("%s (%.2f PA)", displayName(users, awards.Unluckiest.UserID), awards.Unluckiest.Value)})
+	}
+	if awards.BiggestBlowout != nil {
+		winner, loser := awards.BiggestBlowout.WinnerAndLoser()
+		rows = append(rows, [2]string{"💥 Biggest Blowout", fmt.Sprintf("%s over %s by %.2f (Week %d)",
+			displayName(users, winner), displayName(users, loser), awards.BiggestBlowout.Margin(), awards.BiggestBlowout.Week)})
+	}
+	if awards.WorstTrade != nil {
+		winner, _ := awards.WorstTrade.Winner()
+		loser, _ := awards.WorstTrade.Loser()
+		rows = append(rows, [2]string{"🤦 Worst Trade", fmt.Sprintf("%s got %.2f points to %s's %.2f (Week %d)",
+			displayName(users, loser.UserID), loser.Points, displayName(users, winner.UserID), winner.Points, awards.WorstTrade.Trade.Week)})
+	}
+	if awards.DraftSteal != nil {
+		rows = append(rows, [2]string{"💎 Steal of the Draft", fmt.Sprintf("%s, %s's %s pick (finished #%d)",
+			awards.DraftSteal.Pick.Player.Name, displayName(users, awards.DraftSteal.Pick.UserID), awards.DraftSteal.Pick.Label(), awards.DraftSteal.Rank)})
+	}
+	if awards.DraftBust != nil {
+		rows = append(rows, [2]string{"📉 Biggest Bust", fmt.Sprintf("%s, %s's %s pick (finished #%d)",
+			awards.DraftBust.Pick.Player.Name, displayName(users, awards.DraftBust.Pick.UserID), awards.DraftBust.Pick.Label(), awards.DraftBust.Rank)})
+	}
+	if awards.BestWeek != nil {
+		rows = append(rows, [2]string{"🔥 Best Single Week", fmt.Sprintf("%s (%.2f, Week %d)",
+			displayName(users, awards.BestWeek.UserID), awards.BestWeek.Value, awards.BestWeek.Week)})
+	}
+	if awards.HighScoreLeader != nil {
+		rows = append(rows, [2]string{"💰 Weekly High Score Leader", fmt.Sprintf("%s (%.0f)",
+			displayName(users, awards.HighScoreLeader.UserID), awards.HighScoreLeader.Value)})
+	}
+
+	for i, row := range rows {
+		bgColor := "#ffffff"
+		if i%2 == 1 {
+			bgColor = "#f9f9f9"
+		}
+
+		html.WriteString(fmt.Sprintf(`
+                                <tr>
+                                    <td style="padding: 12px 15px; background-color: %s; border-bottom: 1px solid #e0e0e0;">
+                                        <span style="color: #333; font-size: 16px; font-weight: bold;">%s</span><br>
+                                        <span style="color: #666; font-size: 14px;">%s</span>
+                                    </td>
+                                </tr>
+`, bgColor, row[0], row[1]))
+	}
+
+	html.WriteString(`
+                            </table>
+                        </td>
+                    </tr>
+`)
+
+	// Payouts Section
+	if len(awards.Payouts) > 0 {
+		html.WriteString(`
+                    <!-- Payouts -->
+                    <tr>
+                        <td style="padding: 0 20px 30px 20px;">
+                            <h3 style="color: #0a3d0c; margin: 0 0 20px 0; font-size: 20px; text-align: center; font-weight: bold;">💵 FINAL PAYOUTS 💵</h3>
+                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
+`)
+
+		for i, p := range awards.Payouts {
+			bgColor := "#ffffff"
+			if i%2 == 1 {
+				bgColor = "#f9f9f9"
+			}
+
+			html.WriteString(fmt.Sprintf(`
+                                <tr>
+                                    <td style="padding: 12px 15px; background-color: %s; border-bottom: 1px solid #e0e0e0;">
+                                        <span style="color: #333; font-size: 16px;">%s <span style="color: #666;">%s</span></span>
+                                    </td>
+                                </tr>
+`, bgColor, p.UserName, domain.FormatDollars(p.Balance)))
+		}
+
+		html.WriteString(`
+                            </table>
+                        </td>
+                    </tr>
+`)
+	}
+
+	// Footer Section
+	html.WriteString(fmt.Sprintf(`
+                    <!-- Footer -->
+                    <tr>
+                        <td style="background-color: #f8f8f8; padding: 25px 20px; text-align: center; border-top: 2px solid #e0e0e0;">
+                            <p style="color: #555; margin: 0 0 15px 0; font-size: 14px;">Thanks for a great season! See you next year 🏈</p>
+                            <a href="https://sleeper.com/leagues/%s/league" style="display: inline-block; background-color: #0a3d0c; color: #ffffff; text-decoration: none; padding: 12px 30px; border-radius: 6px; font-size: 14px; font-weight: bold;">View on Sleeper →</a>
+                        </td>
+                    </tr>
+`, awards.LeagueID))
+
+	// Close HTML
+	html.WriteString(`
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>`)
+
+	return html.String()
+}
+
+// displayName returns the user's name, falling back to the user ID if the user is unknown
+func displayName(users domain.UserMap, userID string) string {
+	if user, ok := users[userID]; ok && user.Name != "" {
+		return user.Name
+	}
+	return userID
+}
+
+// GenerateYearInReviewHTML creates a manager's personalized year-in-review email
+func GenerateYearInReviewHTML(review domain.YearInReview, users domain.UserMap) string {
+	var html strings.Builder
+
+	html.WriteString(`<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Year in Review</title>
+</head>
+<body style="margin: 0; padding: 0; font-family: Arial, Helvetica, sans-serif; background-color: #f4f4f4;">
+    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f4f4f4;">
+        <tr>
+            <td align="center" style="padding: 20px 0;">
+                <!-- Main Container -->
+                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; background-color: #ffffff; border-radius: 8px; overflow: hidden; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+`)
+
+	// Header Section
+	html.WriteString(fmt.Sprintf(`
+                    <!-- Header -->
+                    <tr>
+                        <td style="background: linear-gradient(135deg, #0a3d0c 0%%, #1a5d1a 100%%); padding: 30px 20px; text-align: center;">
+                            <h1 style="color: #ffffff; margin: 0; font-size: 28px; font-weight: bold;">ANY GIVEN SUNDAY</h1>
+                            <p style="color: #e0e0e0; margin: 10px 0 0 0; font-size: 18px;">%s's %d Year in Review</p>
+                        </td>
+                    </tr>
+`, displayName(users, review.UserID), review.Year))
+
+	// Record Section
+	finish := review.Finish()
+	if finish != "" {
+		finish = strings.ToUpper(finish[:1]) + finish[1:]
+	}
+	html.WriteString(fmt.Sprintf(`
+                    <!-- Record -->
+                    <tr>
+                        <td style="background-color: #ffd700; padding: 30px 20px; text-align: center; border-bottom: 4px solid #f0c000;">
+                            <p style="color: #333; margin: 0 0 10px 0; font-size: 16px; font-weight: bold; text-transform: uppercase; letter-spacing: 1px;">📋 Your Record 📋</p>
+                            <h2 style="color: #000; margin: 10px 0; font-size: 32px; font-weight: bold;">%d-%d-%d</h2>
+                            <p style="color: #333; margin: 10px 0 0 0; font-size: 18px; font-weight: bold;">%s</p>
+                        </td>
+                    </tr>
+`, review.Wins, review.Losses, review.Ties, finish))
+
+	// Highlights Section
+	html.WriteString(`
+                    <!-- Highlights -->
+                    <tr>
+                        <td style="padding: 30px 20px;">
+                            <h3 style="color: #0a3d0c; margin: 0 0 20px 0; font-size: 20px; text-align: center; font-weight: bold;">🎁 YOUR SEASON 🎁</h3>
+                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
+`)
+
+	rows := [][2]string{
+		{"📈 Points For / Against", fmt.Sprintf("%.2f / %.2f", review.PointsFor, review.PointsAgainst)},
+	}
+	if len(review.Ranks) > 0 {
+		ranks := make([]string, len(review.Ranks))
+		for i, rank := range review.Ranks {
+			ranks[i] = fmt.Sprintf("%d", rank)
+		}
+		rows = append(rows, [2]string{"🎢 Rank by Week", strings.Join(ranks, " → ")})
+	}
+	if review.BestWeek != nil {
+		rows = append(rows, [2]string{"🔥 Best Week", fmt.Sprintf("Week %d - %.2f points", review.BestWeek.Week, review.BestWeek.Score)})
+	}
+	if review.WorstWeek != nil {
+		rows = append(rows, [2]string{"🧊 Worst Week", fmt.Sprintf("Week %d - %.2f points", review.WorstWeek.Week, review.WorstWeek.Score)})
+	}
+	if review.MostBeaten != nil {
+		rows = append(rows, [2]string{"😈 Favorite Victim", fmt.Sprintf("%s (%d-%d)", displayName(users, review.MostBeaten.OpponentID), review.MostBeaten.Wins, review.MostBeaten.Losses)})
+	}
+	if review.Nemesis != nil {
+		rows = append(rows, [2]string{"👹 Nemesis", fmt.Sprintf("%s (%d-%d)", displayName(users, review.Nemesis.OpponentID), review.Nemesis.Wins, review.Nemesis.Losses)})
+	}
+	rows = append(rows,
+		[2]string{"🪑 Points Left on Bench", fmt.Sprintf("%.2f", review.BenchPoints)},
+		[2]string{"💰 Money Won/Lost", domain.FormatDollars(review.NetWinnings)},
+	)
+
+	for i, row := range rows {
+		bgColor := "#ffffff"
+		if i%2 == 1 {
+			bgColor = "#f9f9f9"
+		}
+
+		html.WriteString(fmt.Sprintf(`
+                                <tr>
+                                    <td style="padding: 12px 15px; background-color: %s; border-bottom: 1px solid #e0e0e0;">
+                                        <span style="color: #333; font-size: 16px; font-weight: bold;">%s</span><br>
+                                        <span style="color: #666; font-size: 14px;">%s</span>
+                                    </td>
+                                </tr>
+`, bgColor, row[0], row[1]))
+	}
+
+	html.WriteString(`
+                            </table>
+                        </td>
+                    </tr>
+`)
+
+	// Footer Section
+	html.WriteString(`
+                    <!-- Footer -->
+                    <tr>
+                        <td style="background-color: #f8f8f8; padding: 25px 20px; text-align: center; border-top: 2px solid #e0e0e0;">
+                            <p style="color: #555; margin: 0; font-size: 14px;">Thanks for a great season! See you next year 🏈</p>
+                        </td>
+                    </tr>
+`)
+
+	// Close HTML
+	html.WriteString(`
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>`)
+
+	return html.String()
+}
@@ -0,0 +1,95 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+// SeasonAwards formats the end-of-season awards ceremony for Discord
+func SeasonAwards(awards *domain.SeasonAwards, users domain.UserMap) string {
+	var b strings.Builder
+
+	// Header
+	fmt.Fprintf(&b, "🏆 **%d Season Awards** 🏆\n\n", awards.Year)
+
+	// Podium
+	medals := []string{"🥇 **Champion**", "🥈 **Runner-up**", "🥉 **Third Place**"}
+	for place, userID := range awards.Podium {
+		if place >= len(medals) {
+			break
+		}
+		fmt.Fprintf(&b, "%s: %s\n", medals[place], userName(users, userID))
+	}
+	b.WriteString("\n")
+
+	// Regular season awards
+	if awards.MVP != nil {
+		fmt.Fprintf(&b, "⭐ **Regular Season MVP**: %s - %.2f points for\n", userName(users, awards.MVP.UserID), awards.MVP.Value)
+	}
+	if awards.Unluckiest != nil {
+		fmt.Fprintf(&b, "🍀 **Unluckiest**: %s - %.2f points against\n", userName(users, awards.Unluckiest.UserID), awards.Unluckiest.Value)
+	}
+	if awards.BiggestBlowout != nil {
+		m := awards.BiggestBlowout
+		winner, loser := m.WinnerAndLoser()
+		fmt.Fprintf(&b, "💥 **Biggest Blowout**: %s beat %s by %.2f (Week %d)\n",
+			userName(users, winner), userName(users, loser), m.Margin(), m.Week)
+	}
+	if awards.WorstTrade != nil {
+		fmt.Fprintf(&b, "🤦 **Worst Trade**: %s\n", worstTrade(*awards.WorstTrade, users))
+	}
+	if awards.DraftSteal != nil {
+		fmt.Fprintf(&b, "💎 **Steal of the Draft**: %s\n", draftPickValue(*awards.DraftSteal, users))
+	}
+	if awards.DraftBust != nil {
+		fmt.Fprintf(&b, "📉 **Biggest Bust**: %s\n", draftPickValue(*awards.DraftBust, users))
+	}
+	if awards.BestWeek != nil {
+		fmt.Fprintf(&b, "🔥 **Best Single Week**: %s - %.2f points (Week %d)\n",
+			userName(users, awards.BestWeek.UserID), awards.BestWeek.Value, awards.BestWeek.Week)
+	}
+	if awards.HighScoreLeader != nil {
+		fmt.Fprintf(&b, "💰 **Weekly High Score Leader**: %s - %.0f weekly high scores\n",
+			userName(users, awards.HighScoreLeader.UserID), awards.HighScoreLeader.Value)
+	}
+
+	// Final payouts
+	if len(awards.Payouts) > 0 {
+		b.WriteString("\n💵 **Final Payouts:**\n")
+		for _, p := range awards.Payouts {
+			fmt.Fprintf(&b, "%s: %s\n", p.UserName, domain.FormatDollars(p.Balance))
+		}
+	}
+
+	// Footer
+	b.WriteString("\nThanks for a great season! See you next year 🏈")
+
+	return b.String()
+}
+
+// worstTrade describes a lopsided trade from the losing side (e.g. "Sam got 40.20 points from Player A
+// while Alex got 180.50 from Player B (Week 6)")
+func worstTrade(g domain.TradeGrade, users domain.UserMap) string {
+	winner, _ := g.Winner()
+	loser, _ := g.Loser()
+	return fmt.Sprintf("%s got %.2f points from %s while %s got %.2f from %s (Week %d)",
+		userName(users, loser.UserID), loser.Points, loser.Received(),
+		userName(users, winner.UserID), winner.Points, winner.Received(), g.Trade.Week)
+}
+
+// draftPickValue describes a pick against where its player finished (e.g. "Sam's 9.04 pick Player A
+// finished #12 with 180.50 points")
+func draftPickValue(v domain.DraftPickValue, users domain.UserMap) string {
+	return fmt.Sprintf("%s's %s pick %s finished #%d with %.2f points",
+		userName(users, v.Pick.UserID), v.Pick.Label(), v.Pick.Player.Name, v.Rank, v.Points)
+}
+
+// userName returns the user's name, falling back to the user ID if the user is unknown
+func userName(users domain.UserMap, userID string) string {
+	if user, ok := users[userID]; ok && user.Name != "" {
+		return user.Name
+	}
+	return userID
+}
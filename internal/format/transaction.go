@@ -0,0 +1,69 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+// Transaction formats a trade, waiver claim or free agent move for the Discord transactions feed
+func Transaction(t domain.Transaction, users domain.UserMap) string {
+	var b strings.Builder
+
+	switch t.Type {
+	case domain.TransactionTypeTrade:
+		fmt.Fprintf(&b, "🔄 **Trade** (Week %d)\n", t.Week)
+		for _, userID := range t.UserIDs() {
+			players, picks := t.Received(userID)
+			if len(players) == 0 && len(picks) == 0 {
+				continue
+			}
+			fmt.Fprintf(&b, "**%s** receives:\n", userName(users, userID))
+			for _, p := range players {
+				fmt.Fprintf(&b, "   ↳ %s\n", p.Player.Description())
+			}
+			for _, p := range picks {
+				fmt.Fprintf(&b, "   ↳ %s\n", draftPickDescription(p, users))
+			}
+		}
+	case domain.TransactionTypeWaiver:
+		fmt.Fprintf(&b, "📋 **Waiver Claim** (Week %d)\n", t.Week)
+		writeRosterMoves(&b, t, users)
+	default:
+		fmt.Fprintf(&b, "🆓 **Free Agent** (Week %d)\n", t.Week)
+		writeRosterMoves(&b, t, users)
+	}
+
+	return b.String()
+}
+
+// writeRosterMoves writes a waiver claim or free agent move: the players added, with any FAAB bid, then the players dropped
+func writeRosterMoves(b *strings.Builder, t domain.Transaction, users domain.UserMap) {
+	for _, p := range t.Adds() {
+		fmt.Fprintf(b, "**%s** adds %s", userName(users, p.UserID), p.Player.Description())
+		if t.Type == domain.TransactionTypeWaiver {
+			if remaining, ok := t.FAABRemaining(); ok {
+				fmt.Fprintf(b, " for $%d ($%d of $%d FAAB left)", t.WaiverBid, remaining, t.FAABBudget)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	drops := t.Drops()
+	for _, p := range drops {
+		if len(t.Adds()) == 0 {
+			fmt.Fprintf(b, "**%s** drops %s\n", userName(users, p.UserID), p.Player.Description())
+			continue
+		}
+		fmt.Fprintf(b, "   ↳ Drops %s\n", p.Player.Description())
+	}
+}
+
+// draftPickDescription names a traded pick, crediting the team it originally belonged to if it has changed hands before
+func draftPickDescription(p domain.TransactionDraftPick, users domain.UserMap) string {
+	if p.OriginalUserID == "" || p.OriginalUserID == p.FromUserID {
+		return p.Description()
+	}
+	return fmt.Sprintf("%s (originally %s's)", p.Description(), userName(users, p.OriginalUserID))
+}
@@ -15,16 +15,46 @@ func WeeklySummary(summary *interactor.WeeklySummary, users domain.UserMap) stri
 	response += fmt.Sprintf("📊 **Week %d Summary (%d)** 📊\n\n", summary.Week, summary.Year)
 
 	// High Score Winner
-	if summary.HighScore != nil {
-		response += fmt.Sprintf("🏆 **High Score Winner**: %s - %.2f points\n",
-			summary.HighScore.UserName, summary.HighScore.Score)
-		response += "💰 Congrats! You've earned the $15 weekly high score bonus!\n\n"
-	} else {
-		response += "❌ No high score data available for this week\n\n"
+	response += WeeklyHighScore(summary) + "\n"
+
+	// Weekly Awards
+	if len(summary.Awards) > 0 {
+		response += "🎖️ **Weekly Awards:**\n"
+		response += WeeklyAwards(summary, users) + "\n"
 	}
 
 	// Current Standings
 	response += "📈 **Current Standings:**\n"
+	response += WeeklyStandings(summary, users) + "\n"
+
+	// Footer
+	response += WeeklyFooter(summary)
+
+	return response
+}
+
+// WeeklyHighScore formats the high score winner and their bonus
+func WeeklyHighScore(summary *interactor.WeeklySummary) string {
+	if summary.HighScore == nil {
+		return "❌ No high score data available for this week\n"
+	}
+
+	return fmt.Sprintf("🏆 **High Score Winner**: %s - %.2f points\n", summary.HighScore.UserName, summary.HighScore.Score) +
+		fmt.Sprintf("💰 Congrats! You've earned the $%.0f weekly high score bonus!\n", summary.HighScore.PaymentDue)
+}
+
+// WeeklyAwards formats one line per weekly award
+func WeeklyAwards(summary *interactor.WeeklySummary, users domain.UserMap) string {
+	var response string
+	for _, award := range summary.Awards {
+		response += fmt.Sprintf("%s **%s**: %s\n", award.Emoji, award.Name, award.Description(users))
+	}
+	return response
+}
+
+// WeeklyStandings formats the current standings with medals for the top 3
+func WeeklyStandings(summary *interactor.WeeklySummary, users domain.UserMap) string {
+	var response string
 	for i, standing := range summary.Standings {
 		user, exists := users[standing.UserID]
 		name := standing.UserID // Fallback if no name
@@ -49,10 +79,10 @@ func WeeklySummary(summary *interactor.WeeklySummary, users domain.UserMap) stri
 		record := fmt.Sprintf("(%d-%d)", standing.Wins, standing.Losses)
 		response += fmt.Sprintf("%d. %s %s%s\n", i+1, name, record, medal)
 	}
-	response += "\n"
-
-	// Footer
-	response += fmt.Sprintf("Next update after Week %d games complete! 🏈", summary.Week+1)
-
 	return response
 }
+
+// WeeklyFooter formats the note about when the next summary will be posted
+func WeeklyFooter(summary *interactor.WeeklySummary) string {
+	return fmt.Sprintf("Next update after Week %d games complete! 🏈", summary.Week+1)
+}
@@ -0,0 +1,291 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// AdminInteractor holds the commissioner-only actions. Every action is recorded in the admin audit log
+// with the Discord ID of the commissioner who took it.
+type AdminInteractor interface {
+	ForceSync(ctx context.Context, actorID string, year int) error
+	SyncFranchiseHistory(ctx context.Context, actorID string) error
+	SettleLedgerHistory(ctx context.Context, actorID string) error
+	SetPayoutRules(ctx context.Context, actorID string, rules domain.PayoutRules) error
+	AdjustLedger(ctx context.Context, actorID string, adjustment LedgerAdjustment) error
+	SetUserEmail(ctx context.Context, actorID, userID, email string) error
+	LinkUser(ctx context.Context, actorID, userID, discordID string) error
+	UnlinkUser(ctx context.Context, actorID, userID string) error
+	SetLeagueStatus(ctx context.Context, actorID string, year int, status string) error
+	CorrectMatchup(ctx context.Context, actorID string, correction MatchupCorrection) error
+	RecordAdminAction(ctx context.Context, actorID, action, details string) error
+	GetAdminAuditLog(ctx context.Context, limit int) (domain.AdminAuditLog, error)
+}
+
+// LedgerAdjustment is a commissioner's manual change to a user's balance for a season, such as a late fee
+type LedgerAdjustment struct {
+	Year   int
+	UserID string
+	Amount int
+	Note   string
+}
+
+// MatchupCorrection is a commissioner's fix for the scores of a matchup
+type MatchupCorrection struct {
+	Year       int
+	Week       int
+	HomeUserID string
+	AwayUserID string
+	HomeScore  float64
+	AwayScore  float64
+}
+
+// ForceSync syncs the year's matchups, player scores, transactions, draft and traded picks from Sleeper outside of the weekly job.
+// SyncLatestData syncs the draft and traded picks along with the matchups. Like the weekly recap, it also settles
+// the ledger of any past season that has never been settled.
+func (i *interactor) ForceSync(ctx context.Context, actorID string, year int) error {
+	if err := i.SyncLatestData(ctx, year); err != nil {
+		return err
+	}
+	if err := i.SyncTransactions(ctx, year); err != nil {
+		return err
+	}
+	if _, err := i.SettleUnsettledSeasons(ctx); err != nil {
+		return err
+	}
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionSync, fmt.Sprintf("synced %d from Sleeper", year))
+}
+
+// SyncFranchiseHistory records who owned each franchise in every season, oldest first, so franchise history
+// and career stats cover seasons from before franchises were synced weekly.
+func (i *interactor) SyncFranchiseHistory(ctx context.Context, actorID string) error {
+	years, err := i.GetLeagueYears(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get league years: %w", err)
+	}
+
+	for _, year := range years {
+		if err := i.SyncFranchises(ctx, year); err != nil {
+			return fmt.Errorf("failed to sync franchises for %d: %w", year, err)
+		}
+	}
+
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionSyncFranchises, fmt.Sprintf("synced franchise owners for %d seasons", len(years)))
+}
+
+// SettleLedgerHistory settles the ledger of every season, oldest first, so career earnings cover seasons
+// from before the ledger was settled weekly.
+func (i *interactor) SettleLedgerHistory(ctx context.Context, actorID string) error {
+	years, err := i.GetLeagueYears(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get league years: %w", err)
+	}
+
+	for _, year := range years {
+		if err := i.SettleSeasonLedger(ctx, year); err != nil {
+			return fmt.Errorf("failed to settle the ledger for %d: %w", year, err)
+		}
+	}
+
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionSettleLedger, fmt.Sprintf("settled the ledger for %d seasons", len(years)))
+}
+
+// SetPayoutRules records the buy-in and payouts for a season. If the season has started, its ledger is
+// settled again so the new amounts apply to entries already recorded.
+func (i *interactor) SetPayoutRules(ctx context.Context, actorID string, rules domain.PayoutRules) error {
+	err := i.DB.UpsertPayoutRules(ctx, db.UpsertPayoutRulesParams{
+		Year:            int32(rules.Year),
+		BuyIn:           int32(rules.BuyIn),
+		WeeklyHighScore: int32(rules.WeeklyHighScore),
+		FirstPlace:      int32(rules.FirstPlace),
+		SecondPlace:     int32(rules.SecondPlace),
+		ThirdPlace:      int32(rules.ThirdPlace),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set payout rules for year %d: %w", rules.Year, err)
+	}
+
+	if _, err := i.GetLeagueByYear(ctx, rules.Year); err == nil {
+		if err := i.SettleSeasonLedger(ctx, rules.Year); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get league for year %d: %w", rules.Year, err)
+	}
+
+	details := fmt.Sprintf("%d buy-in $%d, weekly high score $%d, podium $%d/$%d/$%d", rules.Year,
+		rules.BuyIn, rules.WeeklyHighScore, rules.FirstPlace, rules.SecondPlace, rules.ThirdPlace)
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionSetPayouts, details)
+}
+
+// AdjustLedger records a manual adjustment to a user's balance. Adjustments for a co-owner are recorded
+// under the owner of their franchise, who they share a ledger with.
+func (i *interactor) AdjustLedger(ctx context.Context, actorID string, adjustment LedgerAdjustment) error {
+	ownerID, err := i.franchiseOwnerID(ctx, adjustment.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = i.DB.InsertLedgerEntry(ctx, db.InsertLedgerEntryParams{
+		Year:      int32(adjustment.Year),
+		UserID:    ownerID,
+		EntryType: domain.LedgerEntryTypeAdjustment,
+		Amount:    int32(adjustment.Amount),
+		Note:      adjustment.Note,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record ledger adjustment for user %s: %w", ownerID, err)
+	}
+
+	details := fmt.Sprintf("%s %s in %d (%s)", ownerID, domain.FormatDollars(adjustment.Amount), adjustment.Year, adjustment.Note)
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionAdjustLedger, details)
+}
+
+// SetUserEmail sets the address a user's recap emails are sent to. An empty email stops their emails.
+func (i *interactor) SetUserEmail(ctx context.Context, actorID, userID, email string) error {
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return fmt.Errorf("invalid email %q: %w", email, err)
+		}
+	}
+
+	user, err := i.DB.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+
+	if err := i.DB.UpdateUserEmail(ctx, db.UpdateUserEmailParams{ID: userID, Email: email}); err != nil {
+		return fmt.Errorf("failed to update email: %w", err)
+	}
+
+	details := fmt.Sprintf("%s email %q -> %q", user.Name, user.Email, email)
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionSetEmail, details)
+}
+
+// LinkUser links a Discord user to a Sleeper user, replacing any existing link on the Sleeper user.
+func (i *interactor) LinkUser(ctx context.Context, actorID, userID, discordID string) error {
+	user, err := i.DB.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+
+	// A Discord user can only be linked to one Sleeper user
+	if existing, err := i.DB.GetUserByDiscordID(ctx, discordID); err == nil && existing.ID != userID {
+		return fmt.Errorf("discord user %s is already linked to %s", discordID, existing.Name)
+	}
+
+	if err := i.DB.SetUserDiscordID(ctx, db.SetUserDiscordIDParams{ID: userID, DiscordID: discordID}); err != nil {
+		return fmt.Errorf("failed to link user: %w", err)
+	}
+
+	details := fmt.Sprintf("%s discord %q -> %q", user.Name, user.DiscordID, discordID)
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionLinkUser, details)
+}
+
+// UnlinkUser removes the link between a Sleeper user and their Discord user so the account can be claimed again.
+func (i *interactor) UnlinkUser(ctx context.Context, actorID, userID string) error {
+	user, err := i.DB.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+
+	if err := i.DB.ClearUserDiscordID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to unlink user: %w", err)
+	}
+
+	details := fmt.Sprintf("%s discord %q -> \"\"", user.Name, user.DiscordID)
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionUnlinkUser, details)
+}
+
+// SetLeagueStatus overrides the status of a league, e.g. to reopen a season that was completed too early.
+// Completing a league goes through the same path as the weekly sync: its podium is recorded from the Sleeper
+// winners bracket and its ledger is settled with the podium payouts.
+func (i *interactor) SetLeagueStatus(ctx context.Context, actorID string, year int, status string) error {
+	if !slices.Contains([]string{domain.LeagueStatusPending, domain.LeagueStatusInProgress, domain.LeagueStatusComplete}, status) {
+		return fmt.Errorf("invalid league status %q", status)
+	}
+
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	if status == domain.LeagueStatusComplete {
+		if err := i.completeSeason(ctx, league); err != nil {
+			return err
+		}
+		if err := i.SettleSeasonLedger(ctx, year); err != nil {
+			return fmt.Errorf("failed to settle the ledger after completing the league: %w", err)
+		}
+	} else if err := i.DB.UpdateLeagueStatus(ctx, db.UpdateLeagueStatusParams{Year: int32(year), Status: status}); err != nil {
+		return fmt.Errorf("failed to update league status: %w", err)
+	}
+
+	details := fmt.Sprintf("%d status %s -> %s", year, league.Status, status)
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionSetLeagueStatus, details)
+}
+
+// CorrectMatchup overwrites the scores of an existing matchup, e.g. after a stat correction Sleeper hasn't picked up,
+// then settles the season's ledger again in case the week's high scorer changed.
+func (i *interactor) CorrectMatchup(ctx context.Context, actorID string, c MatchupCorrection) error {
+	matchup, err := i.DB.GetMatchupByYearWeekUsers(ctx, db.GetMatchupByYearWeekUsersParams{
+		Year:       int32(c.Year),
+		Week:       int32(c.Week),
+		HomeUserID: c.HomeUserID,
+		AwayUserID: c.AwayUserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get matchup: %w", err)
+	}
+
+	err = i.DB.CorrectMatchupScores(ctx, db.CorrectMatchupScoresParams{
+		Year:       int32(c.Year),
+		Week:       int32(c.Week),
+		HomeScore:  c.HomeScore,
+		AwayScore:  c.AwayScore,
+		HomeUserID: c.HomeUserID,
+		AwayUserID: c.AwayUserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update matchup scores: %w", err)
+	}
+
+	if err := i.SettleSeasonLedger(ctx, c.Year); err != nil {
+		return fmt.Errorf("failed to settle the ledger after correcting the matchup: %w", err)
+	}
+
+	details := fmt.Sprintf("%d week %d %s vs %s %.2f-%.2f -> %.2f-%.2f",
+		c.Year, c.Week, c.HomeUserID, c.AwayUserID, matchup.HomeScore, matchup.AwayScore, c.HomeScore, c.AwayScore)
+	return i.RecordAdminAction(ctx, actorID, domain.AdminActionCorrectMatchup, details)
+}
+
+// RecordAdminAction adds an entry to the admin audit log.
+func (i *interactor) RecordAdminAction(ctx context.Context, actorID, action, details string) error {
+	err := i.DB.InsertAdminAuditLog(ctx, db.InsertAdminAuditLogParams{
+		ActorDiscordID: actorID,
+		Action:         action,
+		Details:        details,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record admin action %s: %w", action, err)
+	}
+	return nil
+}
+
+// GetAdminAuditLog retrieves the most recent admin actions, newest first.
+func (i *interactor) GetAdminAuditLog(ctx context.Context, limit int) (domain.AdminAuditLog, error) {
+	rows, err := i.DB.GetAdminAuditLog(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin audit log: %w", err)
+	}
+	return converters.AdminAuditLogFromDB(rows), nil
+}
@@ -0,0 +1,136 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLeagueStatus(t *testing.T) {
+	var updated []db.UpdateLeagueStatusParams
+	mockDB := &dependency.MockDatabase{
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{ID: "league2024", Year: year, Status: domain.LeagueStatusComplete}, nil
+		},
+		UpdateLeagueStatusFunc: func(ctx context.Context, arg db.UpdateLeagueStatusParams) error {
+			updated = append(updated, arg)
+			return nil
+		},
+	}
+	i := newTestInteractor(mockDB, nil)
+	ctx := context.Background()
+
+	require.NoError(t, i.SetLeagueStatus(ctx, "commish", 2024, domain.LeagueStatusInProgress))
+	assert.Equal(t, []db.UpdateLeagueStatusParams{{Year: 2024, Status: domain.LeagueStatusInProgress}}, updated)
+
+	assert.Error(t, i.SetLeagueStatus(ctx, "commish", 2024, "DONE"))
+	assert.Len(t, updated, 1)
+}
+
+func TestSetLeagueStatus_CompleteRecordsPodiumAndSettlesLedger(t *testing.T) {
+	league := db.League{ID: "league2024", Year: 2024, Status: domain.LeagueStatusInProgress}
+	var inserted []db.InsertLedgerEntryParams
+	mockDB := &dependency.MockDatabase{
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return league, nil
+		},
+		CompleteLeagueFunc: func(ctx context.Context, arg db.CompleteLeagueParams) error {
+			league.Status = domain.LeagueStatusComplete
+			league.FirstPlace, league.SecondPlace, league.ThirdPlace = arg.FirstPlace, arg.SecondPlace, arg.ThirdPlace
+			return nil
+		},
+		GetPayoutRulesByYearFunc: func(ctx context.Context, year int32) (db.SeasonPayoutRule, error) {
+			return db.SeasonPayoutRule{}, pgx.ErrNoRows
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			inserted = append(inserted, arg)
+			return nil
+		},
+	}
+	first, third := 1, 3
+	sleeperClient := &dependency.MockSleeperClient{
+		GetWinnersBracketFunc: func(ctx context.Context, leagueID string) (sleeper.Bracket, error) {
+			return sleeper.Bracket{
+				{Round: 3, MatchID: 6, Team1: 1, Team2: 2, Winner: 2, Loser: 1, Placement: &first},
+				{Round: 3, MatchID: 7, Team1: 3, Team2: 4, Winner: 3, Loser: 4, Placement: &third},
+			}, nil
+		},
+		GetRostersInLeagueFunc: func(ctx context.Context, leagueID string) (sleeper.Rosters, error) {
+			return sleeper.Rosters{{ID: 1, OwnerID: "user1"}, {ID: 2, OwnerID: "user2"}, {ID: 3, OwnerID: "user3"}, {ID: 4, OwnerID: "user4"}}, nil
+		},
+	}
+	i := newTestInteractor(mockDB, sleeperClient)
+
+	require.NoError(t, i.SetLeagueStatus(context.Background(), "commish", 2024, domain.LeagueStatusComplete))
+
+	assert.Equal(t, domain.LeagueStatusComplete, league.Status)
+	assert.Equal(t, []string{"user2", "user1", "user3"}, []string{league.FirstPlace, league.SecondPlace, league.ThirdPlace})
+
+	podiumPayouts := make(map[string]string)
+	for _, e := range inserted {
+		switch e.EntryType {
+		case domain.LedgerEntryTypeFirstPlace, domain.LedgerEntryTypeSecondPlace, domain.LedgerEntryTypeThirdPlace:
+			podiumPayouts[e.EntryType] = e.UserID
+		}
+	}
+	assert.Equal(t, map[string]string{
+		domain.LedgerEntryTypeFirstPlace:  "user2",
+		domain.LedgerEntryTypeSecondPlace: "user1",
+		domain.LedgerEntryTypeThirdPlace:  "user3",
+	}, podiumPayouts)
+}
+
+func TestCorrectMatchup_ResettlesTheLedger(t *testing.T) {
+	matchups := []db.Matchup{
+		{Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 100.0},
+	}
+
+	var cleared []int32
+	var inserted []db.InsertLedgerEntryParams
+	mockDB := &dependency.MockDatabase{
+		GetMatchupByYearWeekUsersFunc: func(ctx context.Context, arg db.GetMatchupByYearWeekUsersParams) (db.Matchup, error) {
+			return matchups[0], nil
+		},
+		CorrectMatchupScoresFunc: func(ctx context.Context, arg db.CorrectMatchupScoresParams) error {
+			matchups[0].HomeScore, matchups[0].AwayScore = arg.HomeScore, arg.AwayScore
+			return nil
+		},
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{ID: "league2024", Year: year, Status: domain.LeagueStatusInProgress}, nil
+		},
+		GetMatchupsByYearFunc: func(ctx context.Context, year int32) ([]db.Matchup, error) {
+			return matchups, nil
+		},
+		DeleteSeasonPayoutsFunc: func(ctx context.Context, year int32) error {
+			cleared = append(cleared, year)
+			return nil
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			inserted = append(inserted, arg)
+			return nil
+		},
+	}
+	i := newTestInteractor(mockDB, &dependency.MockSleeperClient{})
+
+	err := i.CorrectMatchup(context.Background(), "commish", MatchupCorrection{
+		Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 130.0,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int32{2024}, cleared)
+	var highScorers []string
+	for _, e := range inserted {
+		if e.EntryType == domain.LedgerEntryTypeWeeklyHighScore {
+			highScorers = append(highScorers, e.UserID)
+		}
+	}
+	assert.Equal(t, []string{"user2"}, highScorers, "the payout moves to the corrected high scorer")
+}
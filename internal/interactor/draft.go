@@ -0,0 +1,132 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+type DraftInteractor interface {
+	SyncDraft(ctx context.Context, year int) error
+	GetDraftReport(ctx context.Context, year int) (domain.DraftReport, error)
+	GetDraftReportForDiscordUser(ctx context.Context, discordID string, year int) (domain.DraftReport, error)
+}
+
+// SyncDraft records the picks of a season's Sleeper draft. Leagues without a draft, or whose draft
+// hasn't started, have no picks to record.
+func (i *interactor) SyncDraft(ctx context.Context, year int) error {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+	if sleeperLeague.DraftID == "" {
+		return nil
+	}
+
+	picks, err := i.SleeperClient.GetDraftPicks(ctx, sleeperLeague.DraftID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft picks from Sleeper: %w", err)
+	}
+	if len(picks) == 0 {
+		return nil
+	}
+
+	rosters, err := i.SleeperClient.GetRostersInLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get rosters from Sleeper: %w", err)
+	}
+
+	// Picks describe the player, so players are named without fetching Sleeper's full player list
+	playerIDs := make([]string, 0, len(picks))
+	for _, p := range picks {
+		playerIDs = append(playerIDs, p.PlayerID)
+	}
+	stored, err := i.DB.GetPlayersByIDs(ctx, playerIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get players: %w", err)
+	}
+	known := converters.PlayersFromDB(stored)
+
+	for _, p := range picks {
+		if _, ok := known[p.PlayerID]; !ok && p.Metadata.Name() != "" {
+			err := i.DB.UpsertPlayer(ctx, db.UpsertPlayerParams{
+				ID:       p.PlayerID,
+				Name:     p.Metadata.Name(),
+				Position: p.Metadata.Position,
+				Team:     p.Metadata.Team,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record player %s: %w", p.PlayerID, err)
+			}
+		}
+
+		// Picks are recorded under the roster's owner, so co-owners' picks count for the franchise
+		err := i.DB.UpsertDraftPick(ctx, db.UpsertDraftPickParams{
+			Year:        int32(year),
+			PickNo:      int32(p.PickNo),
+			Round:       int32(p.Round),
+			DraftSlot:   int32(p.DraftSlot),
+			PlayerID:    p.PlayerID,
+			FranchiseID: int32(p.RosterID),
+			UserID:      rosters.WithID(p.RosterID).OwnerID,
+			IsKeeper:    p.IsKeeper,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record draft pick %d: %w", p.PickNo, err)
+		}
+	}
+
+	return nil
+}
+
+// GetDraftReport compares every pick of a season's draft against what the player scored that season
+func (i *interactor) GetDraftReport(ctx context.Context, year int) (domain.DraftReport, error) {
+	picks, err := i.DB.GetDraftPicksByYear(ctx, int32(year))
+	if err != nil {
+		return domain.DraftReport{}, fmt.Errorf("failed to get draft picks: %w", err)
+	}
+
+	playerIDs := make([]string, 0, len(picks))
+	for _, p := range picks {
+		playerIDs = append(playerIDs, p.PlayerID)
+	}
+	playerMap, err := i.getPlayers(ctx, playerIDs)
+	if err != nil {
+		return domain.DraftReport{}, err
+	}
+
+	scores, err := i.DB.GetPlayerScoresByYear(ctx, int32(year))
+	if err != nil {
+		return domain.DraftReport{}, fmt.Errorf("failed to get player scores: %w", err)
+	}
+
+	return domain.NewDraftReport(year, converters.DraftPicksFromDB(picks, playerMap), converters.PlayerScoresFromDB(scores)), nil
+}
+
+// GetDraftReportForDiscordUser returns the draft report for the picks of the franchise of the Sleeper user linked to a Discord user
+func (i *interactor) GetDraftReportForDiscordUser(ctx context.Context, discordID string, year int) (domain.DraftReport, error) {
+	user, err := i.DB.GetUserByDiscordID(ctx, discordID)
+	if err != nil {
+		return domain.DraftReport{}, fmt.Errorf("failed to get user for Discord ID %s: %w", discordID, err)
+	}
+
+	// Co-owners share the picks of the franchise they manage
+	ownerID, err := i.franchiseOwnerID(ctx, user.ID)
+	if err != nil {
+		return domain.DraftReport{}, err
+	}
+
+	report, err := i.GetDraftReport(ctx, year)
+	if err != nil {
+		return domain.DraftReport{}, err
+	}
+	return report.ForUser(ownerID), nil
+}
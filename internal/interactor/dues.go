@@ -0,0 +1,158 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+type DuesInteractor interface {
+	RecordSeasonBuyIns(ctx context.Context, year int) error
+	GetDuesStatus(ctx context.Context, year int) (domain.DuesStatuses, error)
+	MarkDuesPaid(ctx context.Context, year int, discordID string, amount int, recordedBy string) error
+	MarkDuesUnpaid(ctx context.Context, year int, discordID string) error
+	IsBeforeTradeDeadline(ctx context.Context, year int) (bool, error)
+}
+
+// RecordSeasonBuyIns records a buy-in ledger entry for every roster owner in the season's Sleeper league.
+// This lets dues be tracked before any games have been played. Entries are idempotent.
+func (i *interactor) RecordSeasonBuyIns(ctx context.Context, year int) error {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	rules, err := i.GetPayoutRules(ctx, year)
+	if err != nil {
+		return err
+	}
+
+	rosters, err := i.SleeperClient.GetRostersInLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get rosters from Sleeper: %w", err)
+	}
+
+	for _, roster := range rosters {
+		if roster.OwnerID == "" {
+			continue
+		}
+
+		if _, err := i.DB.GetUserByID(ctx, roster.OwnerID); err != nil {
+			// Log error but continue with other owners
+			log.Printf("Skipping buy-in for unknown Sleeper user %s: %v", roster.OwnerID, err)
+			continue
+		}
+
+		err := i.DB.InsertLedgerEntry(ctx, db.InsertLedgerEntryParams{
+			Year:      int32(year),
+			UserID:    roster.OwnerID,
+			EntryType: domain.LedgerEntryTypeBuyIn,
+			Amount:    int32(-rules.BuyIn),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record buy-in for user %s: %w", roster.OwnerID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetDuesStatus retrieves every member's buy-in and payment status for a season.
+func (i *interactor) GetDuesStatus(ctx context.Context, year int) (domain.DuesStatuses, error) {
+	rows, err := i.DB.GetDuesStatusByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dues status: %w", err)
+	}
+	return converters.DuesStatusesFromDB(rows), nil
+}
+
+// MarkDuesPaid records a buy-in payment for the franchise managed by the Sleeper user linked to a Discord user.
+// An amount of 0 records the season's full buy-in.
+func (i *interactor) MarkDuesPaid(ctx context.Context, year int, discordID string, amount int, recordedBy string) error {
+	user, err := i.DB.GetUserByDiscordID(ctx, discordID)
+	if err != nil {
+		return fmt.Errorf("failed to get user for Discord ID %s: %w", discordID, err)
+	}
+
+	ownerID, err := i.franchiseOwnerID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if amount == 0 {
+		rules, err := i.GetPayoutRules(ctx, year)
+		if err != nil {
+			return err
+		}
+		amount = rules.BuyIn
+	}
+
+	err = i.DB.RecordDuesPayment(ctx, db.RecordDuesPaymentParams{
+		Year:       int32(year),
+		UserID:     ownerID,
+		Amount:     int32(amount),
+		RecordedBy: recordedBy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record dues payment: %w", err)
+	}
+
+	return nil
+}
+
+// MarkDuesUnpaid removes a franchise's recorded buy-in payment, e.g. when it was marked by mistake.
+func (i *interactor) MarkDuesUnpaid(ctx context.Context, year int, discordID string) error {
+	user, err := i.DB.GetUserByDiscordID(ctx, discordID)
+	if err != nil {
+		return fmt.Errorf("failed to get user for Discord ID %s: %w", discordID, err)
+	}
+
+	ownerID, err := i.franchiseOwnerID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = i.DB.DeleteDuesPayment(ctx, db.DeleteDuesPaymentParams{
+		Year:   int32(year),
+		UserID: ownerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete dues payment: %w", err)
+	}
+
+	return nil
+}
+
+// IsBeforeTradeDeadline reports whether the season's trade deadline week has not yet passed.
+// Dues reminders stop once the deadline passes.
+func (i *interactor) IsBeforeTradeDeadline(ctx context.Context, year int) (bool, error) {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return false, fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	if league.Status == domain.LeagueStatusComplete {
+		return false, nil
+	}
+
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+
+	// A league without a trade deadline never stops reminding
+	if sleeperLeague.Settings.TradeDeadline == 0 {
+		return true, nil
+	}
+
+	nflState, err := i.SleeperClient.GetNFLState(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get NFL state: %w", err)
+	}
+
+	return nflState.Week <= sleeperLeague.Settings.TradeDeadline, nil
+}
@@ -0,0 +1,233 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type FranchiseInteractor interface {
+	SyncFranchises(ctx context.Context, year int) error
+	GetFranchises(ctx context.Context) (domain.Franchises, error)
+	GetFranchiseHistory(ctx context.Context, franchiseID int) (domain.FranchiseHistory, error)
+}
+
+// SyncFranchises records every roster in a season's Sleeper league as a franchise, along with who owned and co-owned it that season.
+// The latest season also sets each franchise's current owner and co-owners. Co-owners are added as users so they can
+// link their Discord accounts and get notifications.
+func (i *interactor) SyncFranchises(ctx context.Context, year int) error {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+	return i.syncFranchises(ctx, league)
+}
+
+func (i *interactor) syncFranchises(ctx context.Context, league domain.League) error {
+	current, err := i.isCurrentLeague(ctx, league)
+	if err != nil {
+		return err
+	}
+
+	rosters, err := i.SleeperClient.GetRostersInLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get rosters from Sleeper: %w", err)
+	}
+
+	sleeperUsers, err := i.SleeperClient.GetUsersInLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get users from Sleeper: %w", err)
+	}
+
+	for _, roster := range rosters {
+		// Rosters without an owner are orphaned and have nobody to record
+		if roster.OwnerID == "" {
+			continue
+		}
+		if err := i.syncFranchise(ctx, league.Year, roster, sleeperUsers, current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isCurrentLeague reports whether a league is the latest one, whose rosters decide who currently manages each franchise.
+// A league newer than the latest (i.e. one that hasn't started yet) is current too.
+func (i *interactor) isCurrentLeague(ctx context.Context, league domain.League) (bool, error) {
+	latest, err := i.DB.GetLatestLeague(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get latest league: %w", err)
+	}
+	return league.Year >= int(latest.Year), nil
+}
+
+func (i *interactor) syncFranchise(ctx context.Context, year int, roster sleeper.Roster, sleeperUsers sleeper.SleeperUsers, current bool) error {
+	managerIDs := append([]string{roster.OwnerID}, roster.CoOwners...)
+
+	for _, id := range managerIDs {
+		name := sleeperUsers.WithID(id).DisplayName
+		if name == "" {
+			name = id
+		}
+		if err := i.DB.InsertUserIfMissing(ctx, db.InsertUserIfMissingParams{ID: id, Name: name}); err != nil {
+			return fmt.Errorf("failed to add Sleeper user %s: %w", id, err)
+		}
+	}
+
+	teamName := sleeperUsers.WithID(roster.OwnerID).Metadata.TeamName
+
+	if current {
+		if err := i.syncFranchiseManagers(ctx, roster, teamName, managerIDs); err != nil {
+			return err
+		}
+	} else {
+		// Past seasons only add franchises that have since left the league
+		err := i.DB.InsertFranchiseIfMissing(ctx, db.InsertFranchiseIfMissingParams{
+			ID:      int32(roster.ID),
+			Name:    teamName,
+			OwnerID: roster.OwnerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record franchise %d: %w", roster.ID, err)
+		}
+	}
+
+	err := i.DB.UpsertFranchiseOwner(ctx, db.UpsertFranchiseOwnerParams{
+		FranchiseID: int32(roster.ID),
+		Year:        int32(year),
+		UserID:      roster.OwnerID,
+		TeamName:    teamName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record the %d owner of franchise %d: %w", year, roster.ID, err)
+	}
+
+	return i.syncFranchiseCoOwners(ctx, year, roster)
+}
+
+// syncFranchiseCoOwners records who co-owned a franchise in a season, so co-owners' career stats include it
+func (i *interactor) syncFranchiseCoOwners(ctx context.Context, year int, roster sleeper.Roster) error {
+	return i.inTx(ctx, func(tx *interactor) error {
+		err := tx.DB.DeleteFranchiseCoOwners(ctx, db.DeleteFranchiseCoOwnersParams{FranchiseID: int32(roster.ID), Year: int32(year)})
+		if err != nil {
+			return fmt.Errorf("failed to clear the %d co-owners of franchise %d: %w", year, roster.ID, err)
+		}
+		for _, id := range roster.CoOwners {
+			err := tx.DB.InsertFranchiseCoOwner(ctx, db.InsertFranchiseCoOwnerParams{FranchiseID: int32(roster.ID), Year: int32(year), UserID: id})
+			if err != nil {
+				return fmt.Errorf("failed to record %d co-owner %s of franchise %d: %w", year, id, roster.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// syncFranchiseManagers records a franchise's current name, owner and co-owners. The managers are replaced in one
+// transaction, so co-owners removed in Sleeper are removed here too without a failed sync leaving the franchise
+// with nobody to notify.
+func (i *interactor) syncFranchiseManagers(ctx context.Context, roster sleeper.Roster, teamName string, managerIDs []string) error {
+	return i.inTx(ctx, func(tx *interactor) error {
+		err := tx.DB.UpsertFranchise(ctx, db.UpsertFranchiseParams{
+			ID:      int32(roster.ID),
+			Name:    teamName,
+			OwnerID: roster.OwnerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record franchise %d: %w", roster.ID, err)
+		}
+
+		if err := tx.DB.DeleteFranchiseManagers(ctx, int32(roster.ID)); err != nil {
+			return fmt.Errorf("failed to clear managers of franchise %d: %w", roster.ID, err)
+		}
+		for _, id := range managerIDs {
+			if err := tx.DB.InsertFranchiseManager(ctx, db.InsertFranchiseManagerParams{FranchiseID: int32(roster.ID), UserID: id}); err != nil {
+				return fmt.Errorf("failed to record manager %s of franchise %d: %w", id, roster.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetFranchises retrieves every franchise with its owner and co-owners
+func (i *interactor) GetFranchises(ctx context.Context) (domain.Franchises, error) {
+	franchises, err := i.DB.GetFranchises(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get franchises: %w", err)
+	}
+
+	managers, err := i.DB.GetFranchiseManagers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get franchise managers: %w", err)
+	}
+
+	users, err := i.DB.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	return converters.FranchisesFromDB(franchises, managers, users), nil
+}
+
+// GetFranchiseHistory retrieves a franchise with everyone who has owned it, oldest season first
+func (i *interactor) GetFranchiseHistory(ctx context.Context, franchiseID int) (domain.FranchiseHistory, error) {
+	franchises, err := i.GetFranchises(ctx)
+	if err != nil {
+		return domain.FranchiseHistory{}, err
+	}
+
+	franchise, ok := franchises.ForID(franchiseID)
+	if !ok {
+		return domain.FranchiseHistory{}, fmt.Errorf("franchise %d not found", franchiseID)
+	}
+
+	owners, err := i.DB.GetFranchiseOwners(ctx, int32(franchiseID))
+	if err != nil {
+		return domain.FranchiseHistory{}, fmt.Errorf("failed to get owners of franchise %d: %w", franchiseID, err)
+	}
+
+	return domain.FranchiseHistory{Franchise: franchise, Owners: converters.FranchiseOwnersFromDB(owners)}, nil
+}
+
+// franchiseOwnerID returns the user whose results are recorded for the franchise a user manages.
+// That is the user themselves unless they co-own someone else's franchise.
+func (i *interactor) franchiseOwnerID(ctx context.Context, userID string) (string, error) {
+	franchise, err := i.DB.GetFranchiseByUserID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return userID, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get franchise for user %s: %w", userID, err)
+	}
+	return franchise.OwnerID, nil
+}
+
+// seasonOwners maps each roster ID to the user who owned the franchise in a season, so what a franchise did
+// stays with whoever owned it then after it changes hands. Rosters whose owner hasn't been recorded for the
+// season fall back to the roster's owner in Sleeper.
+func (i *interactor) seasonOwners(ctx context.Context, year int, rosters sleeper.Rosters) (map[int]string, error) {
+	owners := make(map[int]string, len(rosters))
+	for _, roster := range rosters {
+		owners[roster.ID] = roster.OwnerID
+	}
+
+	recorded, err := i.DB.GetFranchiseOwnersByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the %d franchise owners: %w", year, err)
+	}
+	for _, owner := range recorded {
+		owners[int(owner.FranchiseID)] = owner.UserID
+	}
+	return owners, nil
+}
@@ -0,0 +1,159 @@
+package interactor
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFranchises() domain.Franchises {
+	return converters.FranchisesFromDB(
+		[]db.Franchise{
+			{ID: 1, Name: "Dynasty Kings", OwnerID: "owner1"},
+			{ID: 2, Name: "Thunder Bolts", OwnerID: "owner2"},
+		},
+		[]db.FranchiseManager{
+			{FranchiseID: 1, UserID: "coowner1"},
+			{FranchiseID: 1, UserID: "owner1"},
+			{FranchiseID: 2, UserID: "owner2"},
+		},
+		[]db.User{
+			{ID: "owner1", Name: "John Doe", DiscordID: "discord1"},
+			{ID: "coowner1", Name: "Jane Smith", Email: "jane@example.com"},
+			{ID: "owner2", Name: "Bob Jones"},
+			{ID: "former", Name: "Former Manager"},
+		},
+	)
+}
+
+func TestFranchisesFromDB(t *testing.T) {
+	franchises := testFranchises()
+
+	require.Len(t, franchises, 2)
+	require.Len(t, franchises[0].Managers, 2)
+	assert.Equal(t, "owner1", franchises[0].Managers[0].ID, "the owner comes first")
+	assert.Equal(t, "coowner1", franchises[0].Managers[1].ID)
+	assert.Equal(t, "John Doe & Jane Smith", franchises[0].ManagerNames())
+	assert.Equal(t, "Bob Jones", franchises[1].ManagerNames())
+}
+
+func TestFranchises_OwnerOf(t *testing.T) {
+	franchises := testFranchises()
+
+	assert.Equal(t, "owner1", franchises.OwnerOf("owner1"))
+	assert.Equal(t, "owner1", franchises.OwnerOf("coowner1"))
+	assert.Equal(t, "former", franchises.OwnerOf("former"), "users without a franchise are their own owner")
+}
+
+func TestFranchises_ManagersOf(t *testing.T) {
+	franchises := testFranchises()
+
+	managers := franchises.ManagersOf(domain.User{ID: "owner1"})
+	require.Len(t, managers, 2)
+	assert.Equal(t, "jane@example.com", managers[1].Email)
+
+	former := domain.User{ID: "former", Name: "Former Manager"}
+	assert.Equal(t, domain.Users{former}, franchises.ManagersOf(former))
+}
+
+func TestFranchises_WithManagerNames(t *testing.T) {
+	users := domain.UserMap{
+		"owner1":   {ID: "owner1", Name: "John Doe"},
+		"coowner1": {ID: "coowner1", Name: "Jane Smith"},
+		"owner2":   {ID: "owner2", Name: "Bob Jones"},
+	}
+
+	named := testFranchises().WithManagerNames(users)
+
+	assert.Equal(t, "John Doe & Jane Smith", named["owner1"].Name)
+	assert.Equal(t, "Jane Smith", named["coowner1"].Name)
+	assert.Equal(t, "Bob Jones", named["owner2"].Name)
+	assert.Equal(t, "John Doe", users["owner1"].Name, "the original users are left untouched")
+}
+
+func TestDuesStatus_ForManager(t *testing.T) {
+	status := domain.DuesStatus{UserID: "owner1", UserName: "John Doe", DiscordID: "discord1", AmountDue: 100}
+
+	reminder := status.ForManager(domain.User{ID: "coowner1", Name: "Jane Smith", Email: "jane@example.com"})
+
+	assert.Equal(t, "owner1", reminder.UserID, "the buy-in still belongs to the franchise owner")
+	assert.Equal(t, "Jane Smith", reminder.UserName)
+	assert.Equal(t, "", reminder.DiscordID)
+	assert.Equal(t, "jane@example.com", reminder.Email)
+	assert.Equal(t, 100, reminder.Outstanding())
+}
+
+// testFranchiseHistory is franchise 1, owned by former from 2020 to 2021, then owner1 from 2022
+func testFranchiseHistory() domain.FranchiseHistory {
+	franchise, _ := testFranchises().ForID(1)
+	return domain.FranchiseHistory{
+		Franchise: franchise,
+		Owners: converters.FranchiseOwnersFromDB([]db.FranchiseOwner{
+			{FranchiseID: 1, Year: 2020, UserID: "former", TeamName: "Old Guard"},
+			{FranchiseID: 1, Year: 2021, UserID: "former", TeamName: "Old Guard II"},
+			{FranchiseID: 1, Year: 2022, UserID: "owner1", TeamName: "Dynasty Kings"},
+		}),
+	}
+}
+
+func TestFranchiseHistory_Tenures(t *testing.T) {
+	tenures := testFranchiseHistory().Tenures()
+
+	require.Len(t, tenures, 2)
+	assert.Equal(t, domain.FranchiseTenure{UserID: "former", FirstYear: 2020, LastYear: 2021, TeamName: "Old Guard II"}, tenures[0])
+	assert.Equal(t, "2020-2021", tenures[0].Seasons())
+	assert.Equal(t, domain.FranchiseTenure{UserID: "owner1", FirstYear: 2022, LastYear: 2022, TeamName: "Dynasty Kings"}, tenures[1])
+	assert.Equal(t, "2022", tenures[1].Seasons())
+
+	t.Run("returning owners start a new tenure", func(t *testing.T) {
+		history := domain.FranchiseHistory{Owners: []domain.FranchiseOwner{
+			{Year: 2020, UserID: "a"},
+			{Year: 2021, UserID: "b"},
+			{Year: 2022, UserID: "a"},
+		}}
+		assert.Len(t, history.Tenures(), 3)
+	})
+}
+
+func TestFranchiseHistory_OwnerNames(t *testing.T) {
+	users := domain.UserMap{"owner1": {ID: "owner1", Name: "John Doe"}}
+
+	assert.Equal(t, "former (2020-2021), John Doe (2022)", testFranchiseHistory().OwnerNames(users), "unknown users fall back to their ID")
+}
+
+func TestFranchiseHistory_CareerStats(t *testing.T) {
+	final := domain.PlayoffRoundFinals
+	matchups := domain.Matchups{
+		// 2021: former owns the franchise, so owner1's matchups that season belong to another team
+		{Year: 2021, Week: 1, HomeUserID: "former", AwayUserID: "owner2", HomeScore: 120, AwayScore: 100},
+		{Year: 2021, Week: 1, HomeUserID: "owner1", AwayUserID: "other", HomeScore: 150, AwayScore: 90},
+		// 2022: owner1 owns the franchise and wins the title
+		{Year: 2022, Week: 1, HomeUserID: "owner2", AwayUserID: "owner1", HomeScore: 110, AwayScore: 100},
+		{Year: 2022, Week: 2, HomeUserID: "owner1", AwayUserID: "owner2", HomeScore: 130, AwayScore: 80},
+		{Year: 2022, Week: 15, IsPlayoff: true, PlayoffRound: &final, HomeUserID: "owner1", AwayUserID: "owner2", HomeScore: 140, AwayScore: 120},
+		// former's matchups after selling the franchise don't count for it
+		{Year: 2022, Week: 1, HomeUserID: "former", AwayUserID: "other", HomeScore: 90, AwayScore: 95},
+	}
+
+	stats := testFranchiseHistory().CareerStats(matchups)
+
+	assert.Equal(t, "owner1", stats.UserID, "the current owner's avatar represents the franchise")
+	assert.Equal(t, "Dynasty Kings", stats.UserName)
+	assert.Equal(t, int64(2), stats.SeasonsPlayed)
+	assert.Equal(t, "2-1", stats.RegularSeasonRecord)
+	assert.Equal(t, 350.0, stats.RegularSeasonPointsFor)
+	assert.Equal(t, 290.0, stats.RegularSeasonPointsAgainst)
+	assert.InDelta(t, 116.67, stats.RegularSeasonAvgPoints, 0.01)
+	assert.Equal(t, 130.0, stats.HighestRegularSeasonScore)
+	assert.Equal(t, int64(1), stats.WeeklyHighScores, "only 2022 week 2 was the franchise's week high")
+	assert.Equal(t, int64(1), stats.PlayoffAppearances)
+	assert.Equal(t, "1-0", stats.PlayoffRecord)
+	assert.Equal(t, int64(1), stats.FinalsAppearances)
+	assert.Equal(t, int64(1), stats.FirstPlaceFinishes)
+	assert.Equal(t, 140.0, stats.PlayoffAvgPoints)
+}
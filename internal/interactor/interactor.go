@@ -1,6 +1,8 @@
 package interactor
 
 import (
+	"context"
+
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 )
 
@@ -14,8 +16,25 @@ type Interactor interface {
 	UsersInteractor
 	WeeklyJobInteractor
 	OnboardingInteractor
+	LedgerInteractor
+	DuesInteractor
+	SeasonInteractor
+	YearInReviewInteractor
+	AdminInteractor
+	FranchiseInteractor
+	TransactionInteractor
+	DraftInteractor
+	KeeperInteractor
+	WaiverInteractor
 }
 
 func NewInteractor(c *dependency.Chain) *interactor {
 	return &interactor{Chain: c}
 }
+
+// inTx runs fn with an interactor whose database queries all run in one transaction, see dependency.Chain.InTx
+func (i *interactor) inTx(ctx context.Context, fn func(tx *interactor) error) error {
+	return i.Chain.InTx(ctx, func(c *dependency.Chain) error {
+		return fn(NewInteractor(c))
+	})
+}
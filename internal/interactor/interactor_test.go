@@ -0,0 +1,11 @@
+package interactor
+
+import (
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
+)
+
+// newTestInteractor creates an interactor on a dependency chain of the given database and Sleeper client
+func newTestInteractor(database dependency.IDatabase, sleeperClient sleeper.ISleeperClient) *interactor {
+	return NewInteractor(&dependency.Chain{DB: database, SleeperClient: sleeperClient})
+}
@@ -0,0 +1,135 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+type KeeperInteractor interface {
+	SyncTradedPicks(ctx context.Context, year int) error
+	GetKeeperReport(ctx context.Context) (domain.KeeperReport, error)
+	GetKeeperReportForDiscordUser(ctx context.Context, discordID string) (domain.KeeperReport, error)
+}
+
+// SyncTradedPicks records who owns every traded draft pick in a season's league, including picks in future drafts
+func (i *interactor) SyncTradedPicks(ctx context.Context, year int) error {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	picks, err := i.SleeperClient.GetTradedPicks(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get traded picks from Sleeper: %w", err)
+	}
+
+	for _, p := range picks {
+		season, err := strconv.Atoi(p.Season)
+		if err != nil {
+			return fmt.Errorf("invalid traded pick season %q: %w", p.Season, err)
+		}
+		err = i.DB.UpsertTradedDraftPick(ctx, db.UpsertTradedDraftPickParams{
+			Season:              int32(season),
+			Round:               int32(p.Round),
+			OriginalFranchiseID: int32(p.RosterID),
+			OwnerFranchiseID:    int32(p.OwnerID),
+			PreviousFranchiseID: int32(p.PreviousOwnerID),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record %s round %d pick of roster %d: %w", p.Season, p.Round, p.RosterID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetKeeperReport works out what each rostered player in the latest league would cost to keep at the next
+// keeper deadline, and which upcoming draft picks each franchise owns. Until the latest league has drafted,
+// the deadline is for that league's season, otherwise it is for the following season.
+func (i *interactor) GetKeeperReport(ctx context.Context) (domain.KeeperReport, error) {
+	league, err := i.GetLatestLeague(ctx)
+	if err != nil {
+		return domain.KeeperReport{}, fmt.Errorf("failed to get latest league: %w", err)
+	}
+
+	year := league.Year + 1
+	if league.Status == domain.LeagueStatusPending {
+		year = league.Year
+	}
+
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return domain.KeeperReport{}, fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+	rules := domain.NewKeeperRules(sleeperLeague.Settings.MaxKeepers, sleeperLeague.Settings.TaxiSlots, sleeperLeague.Settings.DraftRounds)
+
+	rosters, err := i.SleeperClient.GetRostersInLeague(ctx, league.ID)
+	if err != nil {
+		return domain.KeeperReport{}, fmt.Errorf("failed to get rosters from Sleeper: %w", err)
+	}
+
+	keeperRosters := make([]domain.KeeperRoster, 0, len(rosters))
+	var playerIDs []string
+	for _, r := range rosters {
+		keeperRosters = append(keeperRosters, domain.KeeperRoster{FranchiseID: r.ID, Players: r.Players, Taxi: r.Taxi})
+		playerIDs = append(playerIDs, r.Players...)
+	}
+	players, err := i.getPlayers(ctx, playerIDs)
+	if err != nil {
+		return domain.KeeperReport{}, err
+	}
+
+	// Years kept can run back through every earlier draft
+	years, err := i.GetLeagueYears(ctx)
+	if err != nil {
+		return domain.KeeperReport{}, fmt.Errorf("failed to get league years: %w", err)
+	}
+	drafts := make(map[int]domain.DraftPicks)
+	for _, y := range years {
+		if y >= year {
+			continue
+		}
+		picks, err := i.DB.GetDraftPicksByYear(ctx, int32(y))
+		if err != nil {
+			return domain.KeeperReport{}, fmt.Errorf("failed to get %d draft picks: %w", y, err)
+		}
+		drafts[y] = converters.DraftPicksFromDB(picks, players)
+	}
+
+	traded, err := i.DB.GetTradedDraftPicksFromSeason(ctx, int32(year))
+	if err != nil {
+		return domain.KeeperReport{}, fmt.Errorf("failed to get traded draft picks: %w", err)
+	}
+
+	franchises, err := i.GetFranchises(ctx)
+	if err != nil {
+		return domain.KeeperReport{}, err
+	}
+
+	return domain.NewKeeperReport(year, rules, franchises, keeperRosters, drafts, converters.TradedDraftPicksFromDB(traded), players), nil
+}
+
+// GetKeeperReportForDiscordUser returns the keeper options of the franchise of the Sleeper user linked to a Discord user
+func (i *interactor) GetKeeperReportForDiscordUser(ctx context.Context, discordID string) (domain.KeeperReport, error) {
+	user, err := i.DB.GetUserByDiscordID(ctx, discordID)
+	if err != nil {
+		return domain.KeeperReport{}, fmt.Errorf("failed to get user for Discord ID %s: %w", discordID, err)
+	}
+
+	// Co-owners share the keepers of the franchise they manage
+	ownerID, err := i.franchiseOwnerID(ctx, user.ID)
+	if err != nil {
+		return domain.KeeperReport{}, err
+	}
+
+	report, err := i.GetKeeperReport(ctx)
+	if err != nil {
+		return domain.KeeperReport{}, err
+	}
+	return report.ForUser(ownerID), nil
+}
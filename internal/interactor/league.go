@@ -11,7 +11,9 @@ import (
 type LeagueInteractor interface {
 	GetLatestLeague(ctx context.Context) (domain.League, error)
 	GetLeagueByYear(ctx context.Context, year int) (domain.League, error)
+	GetLeagueYears(ctx context.Context) ([]int, error)
 	GetStandingsForLeague(ctx context.Context, league domain.League) (domain.Standings, error)
+	GetRegularSeasonStandingsForLeague(ctx context.Context, league domain.League) (domain.Standings, error)
 }
 
 // GetLatestLeague retrieves the latest league from the database.
@@ -33,6 +35,34 @@ func (i *interactor) GetLeagueByYear(ctx context.Context, year int) (domain.Leag
 	return converters.LeagueFromDB(league), nil
 }
 
+// GetLeagueYears retrieves the years of every league that has started, in ascending order.
+func (i *interactor) GetLeagueYears(ctx context.Context) ([]int, error) {
+	years, err := i.DB.GetLeagueYears(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]int, len(years))
+	for idx, year := range years {
+		result[idx] = int(year)
+	}
+	return result, nil
+}
+
+// GetRegularSeasonStandingsForLeague retrieves the standings for a given league sorted by regular season record,
+// ignoring playoff results even if the league is complete.
+func (i *interactor) GetRegularSeasonStandingsForLeague(ctx context.Context, league domain.League) (domain.Standings, error) {
+	if league.Status == domain.LeagueStatusPending {
+		return domain.Standings{}, errors.New("league year has not started yet")
+	}
+
+	matchups, err := i.DB.GetMatchupsByYear(ctx, int32(league.Year))
+	if err != nil {
+		return domain.Standings{}, err
+	}
+	return domain.MatchupsToStandingsMap(converters.MatchupsFromDB(matchups)).SortStandingsMap(), nil
+}
+
 // GetStandingsForLeague retrieves the sorted standings for a given league.
 func (i *interactor) GetStandingsForLeague(ctx context.Context, league domain.League) (domain.Standings, error) {
 	if league.Status == domain.LeagueStatusPending {
@@ -82,13 +112,19 @@ func (i *interactor) GetStandingsForLeague(ctx context.Context, league domain.Le
 		for _, q := range quarterfinals {
 			quarterfinalLosers = append(quarterfinalLosers, q.Loser())
 		}
-		sortedQuarterfinalLosers := domain.Standings{standingsMap[quarterfinalLosers[0]], standingsMap[quarterfinalLosers[1]]}.SortStandings()
+		// Teams without regular season matchups recorded have no standing to place
+		var quarterfinalLoserStandings domain.Standings
+		for _, loserID := range quarterfinalLosers {
+			if standing, ok := standingsMap[loserID]; ok {
+				quarterfinalLoserStandings = append(quarterfinalLoserStandings, standing)
+			}
+		}
+		finalStandings := append(domain.Standings{first, second, third, fourth}, quarterfinalLoserStandings.SortStandings()...)
 
 		// 7th thru 12th place are the remaining teams
-		finalStandings := append(
-			domain.Standings{first, second, third, fourth, sortedQuarterfinalLosers[0], sortedQuarterfinalLosers[1]},
-			sortedStandings[6:]...,
-		)
+		if len(sortedStandings) > 6 {
+			finalStandings = append(finalStandings, sortedStandings[6:]...)
+		}
 
 		return finalStandings, nil
 	}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
-	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
 	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 
 	"github.com/google/uuid"
@@ -15,100 +14,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// testableInteractor allows us to test with mock dependencies
-type testableInteractor struct {
-	chain *dependency.TestChain
-}
-
-func (i *testableInteractor) GetLatestLeague(ctx context.Context) (domain.League, error) {
-	league, err := i.chain.DB.GetLatestLeague(ctx)
-	if err != nil {
-		return domain.League{}, err
-	}
-	return converters.LeagueFromDB(league), nil
-}
-
-func (i *testableInteractor) GetLeagueByYear(ctx context.Context, year int) (domain.League, error) {
-	league, err := i.chain.DB.GetLeagueByYear(ctx, int32(year))
-	if err != nil {
-		return domain.League{}, err
-	}
-	return converters.LeagueFromDB(league), nil
-}
-
-func (i *testableInteractor) GetStandingsForLeague(ctx context.Context, league domain.League) (domain.Standings, error) {
-	if league.Status == domain.LeagueStatusPending {
-		return domain.Standings{}, errors.New("league year has not started yet")
-	}
-
-	matchups, err := i.chain.DB.GetMatchupsByYear(ctx, int32(league.Year))
-	if err != nil {
-		return domain.Standings{}, err
-	}
-	allMatchups := converters.MatchupsFromDB(matchups)
-	standingsMap := domain.MatchupsToStandingsMap(allMatchups)
-	sortedStandings := standingsMap.SortStandingsMap()
-
-	if league.Status == domain.LeagueStatusComplete {
-		matchupsByRound := map[string]domain.Matchups{}
-		for _, m := range allMatchups {
-			if !m.IsPlayoff || m.PlayoffRound == nil {
-				continue
-			}
-			matchupsByRound[*m.PlayoffRound] = append(matchupsByRound[*m.PlayoffRound], m)
-		}
-
-		finals, ok := matchupsByRound[domain.PlayoffRoundFinals]
-		if !ok || len(finals) != 1 {
-			return domain.Standings{}, errors.New("invalid finals data")
-		}
-		finalsWinner, finalsLoser := finals[0].WinnerAndLoser()
-		first, second := standingsMap[finalsWinner], standingsMap[finalsLoser]
-
-		thirdPlaceGame, ok := matchupsByRound[domain.PlayoffRoundThirdPlace]
-		if !ok || len(thirdPlaceGame) != 1 {
-			return domain.Standings{}, errors.New("invalid third place game data")
-		}
-		thirdPlaceGameWinner, thirdPlaceGameLoser := thirdPlaceGame[0].WinnerAndLoser()
-		third, fourth := standingsMap[thirdPlaceGameWinner], standingsMap[thirdPlaceGameLoser]
-
-		quarterfinals, ok := matchupsByRound[domain.PlayoffRoundQuarterfinals]
-		if !ok || len(quarterfinals) != 2 {
-			return domain.Standings{}, errors.New("invalid quarterfinals data")
-		}
-		var quarterfinalLosers []string
-		for _, q := range quarterfinals {
-			quarterfinalLosers = append(quarterfinalLosers, q.Loser())
-		}
-
-		// Build quarterfinal losers standings, skipping nil entries
-		var quarterfinalLoserStandings domain.Standings
-		for _, loserID := range quarterfinalLosers {
-			if standing, exists := standingsMap[loserID]; exists && standing != nil {
-				quarterfinalLoserStandings = append(quarterfinalLoserStandings, standing)
-			}
-		}
-		sortedQuarterfinalLosers := quarterfinalLoserStandings.SortStandings()
-
-		// Ensure we have at least 2 quarterfinal losers
-		finalStandings := domain.Standings{first, second, third, fourth}
-		if len(sortedQuarterfinalLosers) >= 2 {
-			finalStandings = append(finalStandings, sortedQuarterfinalLosers[0], sortedQuarterfinalLosers[1])
-		}
-		if len(sortedStandings) > 6 {
-			finalStandings = append(finalStandings, sortedStandings[6:]...)
-		}
-
-		return finalStandings, nil
-	}
-
-	return sortedStandings, nil
-}
-
-func newTestableInteractor(chain *dependency.TestChain) *testableInteractor {
-	return &testableInteractor{chain: chain}
-}
-
 func TestGetLatestLeague(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -151,8 +56,7 @@ func TestGetLatestLeague(t *testing.T) {
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, nil, nil)
-			interactor := newTestableInteractor(chain)
+			interactor := newTestInteractor(mockDB, nil)
 
 			result, err := interactor.GetLatestLeague(context.Background())
 
@@ -227,8 +131,7 @@ func TestGetLeagueByYear(t *testing.T) {
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, nil, nil)
-			interactor := newTestableInteractor(chain)
+			interactor := newTestInteractor(mockDB, nil)
 
 			result, err := interactor.GetLeagueByYear(context.Background(), tt.inputYear)
 
@@ -340,8 +243,7 @@ func TestGetStandingsForLeague(t *testing.T) {
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, nil, nil)
-			interactor := newTestableInteractor(chain)
+			interactor := newTestInteractor(mockDB, nil)
 
 			result, err := interactor.GetStandingsForLeague(context.Background(), tt.inputLeague)
 
@@ -0,0 +1,226 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+type LedgerInteractor interface {
+	GetPayoutRules(ctx context.Context, year int) (domain.PayoutRules, error)
+	GetLedgerForDiscordUser(ctx context.Context, discordID string, year int) (domain.Ledger, error)
+	GetLedgerBalances(ctx context.Context, year int) (domain.LedgerBalances, error)
+	SettleSeasonLedger(ctx context.Context, year int) error
+	SettleUnsettledSeasons(ctx context.Context) ([]int, error)
+}
+
+// GetPayoutRules retrieves the payout rules recorded for a season.
+// Seasons without recorded rules fall back to the league defaults.
+func (i *interactor) GetPayoutRules(ctx context.Context, year int) (domain.PayoutRules, error) {
+	rules, err := i.DB.GetPayoutRulesByYear(ctx, int32(year))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.DefaultPayoutRules(year), nil
+	}
+	if err != nil {
+		return domain.PayoutRules{}, fmt.Errorf("failed to get payout rules for year %d: %w", year, err)
+	}
+	return converters.PayoutRulesFromDB(rules), nil
+}
+
+// GetLedgerForDiscordUser retrieves the itemized ledger of the Sleeper user linked to a Discord user.
+func (i *interactor) GetLedgerForDiscordUser(ctx context.Context, discordID string, year int) (domain.Ledger, error) {
+	user, err := i.DB.GetUserByDiscordID(ctx, discordID)
+	if err != nil {
+		return domain.Ledger{}, fmt.Errorf("failed to get user for Discord ID %s: %w", discordID, err)
+	}
+
+	// Co-owners share the ledger of the franchise they manage
+	ownerID, err := i.franchiseOwnerID(ctx, user.ID)
+	if err != nil {
+		return domain.Ledger{}, err
+	}
+
+	entries, err := i.DB.GetLedgerEntriesByUserAndYear(ctx, db.GetLedgerEntriesByUserAndYearParams{
+		UserID: ownerID,
+		Year:   int32(year),
+	})
+	if err != nil {
+		return domain.Ledger{}, fmt.Errorf("failed to get ledger entries: %w", err)
+	}
+
+	return domain.Ledger{
+		UserID:   ownerID,
+		UserName: user.Name,
+		Year:     year,
+		Entries:  converters.LedgerEntriesFromDB(entries),
+	}, nil
+}
+
+// GetLedgerBalances retrieves every user's net balance for a season, highest first.
+func (i *interactor) GetLedgerBalances(ctx context.Context, year int) (domain.LedgerBalances, error) {
+	balances, err := i.DB.GetLedgerBalancesByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger balances: %w", err)
+	}
+	return converters.LedgerBalancesFromDB(balances), nil
+}
+
+// SettleSeasonLedger records the buy-ins, weekly high scores and (for completed seasons) podium payouts
+// for a season using that season's payout rules. The season's payouts are replaced in one transaction,
+// so this is safe to re-run after scores change and a failure never leaves the ledger half settled.
+func (i *interactor) SettleSeasonLedger(ctx context.Context, year int) error {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	rules, err := i.GetPayoutRules(ctx, year)
+	if err != nil {
+		return err
+	}
+
+	matchups, err := i.DB.GetMatchupsByYear(ctx, int32(year))
+	if err != nil {
+		return fmt.Errorf("failed to get matchups for year %d: %w", year, err)
+	}
+
+	// Synced playoff games aren't flagged as playoffs, so use the league's playoff start week as well
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+
+	var podium []string
+	if league.Status == domain.LeagueStatusComplete {
+		podium, err = i.getPodium(ctx, league)
+		if err != nil {
+			return fmt.Errorf("failed to determine podium for year %d: %w", year, err)
+		}
+	}
+
+	entries := buildSeasonLedgerEntries(rules, converters.MatchupsFromDB(matchups), sleeperLeague.Settings.PlayoffWeekStart, podium)
+	return i.inTx(ctx, func(tx *interactor) error {
+		if err := tx.DB.DeleteSeasonPayouts(ctx, int32(year)); err != nil {
+			return fmt.Errorf("failed to clear payouts for year %d: %w", year, err)
+		}
+
+		for _, e := range entries {
+			err := tx.DB.InsertLedgerEntry(ctx, db.InsertLedgerEntryParams{
+				Year:      int32(e.Year),
+				UserID:    e.UserID,
+				EntryType: e.Type,
+				Week:      int32(e.Week),
+				Amount:    int32(e.Amount),
+				Note:      e.Note,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record %s ledger entry for user %s: %w", e.Type, e.UserID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// SettleUnsettledSeasons settles every season that has no weekly high scores in the ledger yet, oldest first,
+// so career earnings include seasons played before the ledger existed. It returns the seasons it settled.
+func (i *interactor) SettleUnsettledSeasons(ctx context.Context) ([]int, error) {
+	years, err := i.GetLeagueYears(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league years: %w", err)
+	}
+
+	settled, err := i.DB.GetSettledLedgerYears(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settled ledger years: %w", err)
+	}
+
+	var newlySettled []int
+	for _, year := range years {
+		if slices.Contains(settled, int32(year)) {
+			continue
+		}
+		if err := i.SettleSeasonLedger(ctx, year); err != nil {
+			return newlySettled, fmt.Errorf("failed to settle the ledger for %d: %w", year, err)
+		}
+		newlySettled = append(newlySettled, year)
+	}
+
+	return newlySettled, nil
+}
+
+// getPodium returns the user IDs of the first, second and third place finishers of a completed league.
+// Recorded league results take precedence over results derived from the playoff bracket.
+func (i *interactor) getPodium(ctx context.Context, league domain.League) ([]string, error) {
+	if league.FirstPlace != "" && league.SecondPlace != "" && league.ThirdPlace != "" {
+		return []string{league.FirstPlace, league.SecondPlace, league.ThirdPlace}, nil
+	}
+
+	standings, err := i.GetStandingsForLeague(ctx, league)
+	if err != nil {
+		return nil, err
+	}
+	if len(standings) < 3 {
+		return nil, errors.New("not enough teams in final standings")
+	}
+
+	return []string{standings[0].UserID, standings[1].UserID, standings[2].UserID}, nil
+}
+
+// buildSeasonLedgerEntries derives the ledger entries for a season from its matchups.
+// Every team that played a regular season game owes the buy-in, every regular season weekly high score
+// (including ties) earns the weekly payout, and the podium (first, second, third) earns the place payouts.
+func buildSeasonLedgerEntries(rules domain.PayoutRules, matchups domain.Matchups, playoffWeekStart int, podium []string) domain.LedgerEntries {
+	var entries domain.LedgerEntries
+	matchups = regularSeasonMatchups(matchups, playoffWeekStart)
+
+	seen := make(map[string]bool)
+	weeklyMax := make(map[int]float64)
+	for _, m := range matchups {
+		for _, userID := range []string{m.HomeUserID, m.AwayUserID} {
+			if !seen[userID] {
+				seen[userID] = true
+				entries = append(entries, domain.LedgerEntry{
+					Year:   rules.Year,
+					UserID: userID,
+					Type:   domain.LedgerEntryTypeBuyIn,
+					Amount: -rules.BuyIn,
+				})
+			}
+		}
+		weeklyMax[m.Week] = max(weeklyMax[m.Week], m.HomeScore, m.AwayScore)
+	}
+
+	for _, m := range matchups {
+		for _, winner := range m.WeeklyHighScorers(weeklyMax[m.Week]) {
+			entries = append(entries, domain.LedgerEntry{
+				Year:   rules.Year,
+				UserID: winner,
+				Type:   domain.LedgerEntryTypeWeeklyHighScore,
+				Week:   m.Week,
+				Amount: rules.WeeklyHighScore,
+			})
+		}
+	}
+
+	placeTypes := []string{domain.LedgerEntryTypeFirstPlace, domain.LedgerEntryTypeSecondPlace, domain.LedgerEntryTypeThirdPlace}
+	placeAmounts := []int{rules.FirstPlace, rules.SecondPlace, rules.ThirdPlace}
+	for place, userID := range podium {
+		if place >= len(placeTypes) || userID == "" {
+			break
+		}
+		entries = append(entries, domain.LedgerEntry{
+			Year:   rules.Year,
+			UserID: userID,
+			Type:   placeTypes[place],
+			Amount: placeAmounts[place],
+		})
+	}
+
+	return entries
+}
@@ -0,0 +1,335 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSeasonLedgerEntries(t *testing.T) {
+	finals := domain.PlayoffRoundFinals
+	rules := domain.PayoutRules{
+		Year:            2024,
+		BuyIn:           120,
+		WeeklyHighScore: 20,
+		FirstPlace:      700,
+		SecondPlace:     350,
+		ThirdPlace:      150,
+	}
+
+	tests := []struct {
+		name             string
+		matchups         domain.Matchups
+		playoffWeekStart int
+		podium           []string
+		expectedEntries  domain.LedgerEntries
+		expectedBalances map[string]int
+	}{
+		{
+			name: "regular season buy-ins and weekly high scores",
+			matchups: domain.Matchups{
+				{Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.5, AwayScore: 99.1},
+				{Year: 2024, Week: 1, HomeUserID: "user3", AwayUserID: "user4", HomeScore: 101.2, AwayScore: 140.3},
+				{Year: 2024, Week: 2, HomeUserID: "user1", AwayUserID: "user3", HomeScore: 88.0, AwayScore: 131.7},
+				{Year: 2024, Week: 2, HomeUserID: "user2", AwayUserID: "user4", HomeScore: 110.0, AwayScore: 105.0},
+			},
+			expectedEntries: domain.LedgerEntries{
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user2", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user3", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user4", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user4", Type: domain.LedgerEntryTypeWeeklyHighScore, Week: 1, Amount: 20},
+				{Year: 2024, UserID: "user3", Type: domain.LedgerEntryTypeWeeklyHighScore, Week: 2, Amount: 20},
+			},
+			expectedBalances: map[string]int{"user1": -120, "user2": -120, "user3": -100, "user4": -100},
+		},
+		{
+			name: "tied weekly high scores both get paid",
+			matchups: domain.Matchups{
+				{Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 130.0, AwayScore: 99.1},
+				{Year: 2024, Week: 1, HomeUserID: "user3", AwayUserID: "user4", HomeScore: 101.2, AwayScore: 130.0},
+			},
+			expectedEntries: domain.LedgerEntries{
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user2", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user3", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user4", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeWeeklyHighScore, Week: 1, Amount: 20},
+				{Year: 2024, UserID: "user4", Type: domain.LedgerEntryTypeWeeklyHighScore, Week: 1, Amount: 20},
+			},
+			expectedBalances: map[string]int{"user1": -100, "user2": -120, "user3": -120, "user4": -100},
+		},
+		{
+			name: "playoff games are ignored and podium is paid",
+			matchups: domain.Matchups{
+				{Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 100.0},
+				{Year: 2024, Week: 16, IsPlayoff: true, PlayoffRound: &finals, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 200.0, AwayScore: 100.0},
+			},
+			podium: []string{"user1", "user2", "user3"},
+			expectedEntries: domain.LedgerEntries{
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user2", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeWeeklyHighScore, Week: 1, Amount: 20},
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeFirstPlace, Amount: 700},
+				{Year: 2024, UserID: "user2", Type: domain.LedgerEntryTypeSecondPlace, Amount: 350},
+				{Year: 2024, UserID: "user3", Type: domain.LedgerEntryTypeThirdPlace, Amount: 150},
+			},
+			expectedBalances: map[string]int{"user1": 600, "user2": 230, "user3": 150},
+		},
+		{
+			name: "unflagged games from the playoff start week on are ignored",
+			matchups: domain.Matchups{
+				{Year: 2024, Week: 14, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 100.0},
+				{Year: 2024, Week: 15, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 90.0, AwayScore: 180.0},
+			},
+			playoffWeekStart: 15,
+			expectedEntries: domain.LedgerEntries{
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user2", Type: domain.LedgerEntryTypeBuyIn, Amount: -120},
+				{Year: 2024, UserID: "user1", Type: domain.LedgerEntryTypeWeeklyHighScore, Week: 14, Amount: 20},
+			},
+			expectedBalances: map[string]int{"user1": -100, "user2": -120},
+		},
+		{
+			name:     "no matchups produces no entries",
+			matchups: domain.Matchups{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := buildSeasonLedgerEntries(rules, tt.matchups, tt.playoffWeekStart, tt.podium)
+
+			assert.Equal(t, tt.expectedEntries, entries)
+
+			balances := make(map[string]int)
+			for _, e := range entries {
+				balances[e.UserID] += e.Amount
+			}
+			for userID, expected := range tt.expectedBalances {
+				assert.Equal(t, expected, balances[userID], "balance for %s", userID)
+			}
+		})
+	}
+}
+
+func TestLedgerEntries_Balance(t *testing.T) {
+	entries := domain.LedgerEntries{
+		{Type: domain.LedgerEntryTypeBuyIn, Amount: -100},
+		{Type: domain.LedgerEntryTypeWeeklyHighScore, Week: 3, Amount: 15},
+		{Type: domain.LedgerEntryTypeAdjustment, Amount: -5, Note: "late fee"},
+	}
+
+	assert.Equal(t, -90, entries.Balance())
+	assert.Equal(t, "-$90", domain.FormatDollars(entries.Balance()))
+	assert.Equal(t, "Week 3 high score", entries[1].Description())
+	assert.Equal(t, "Adjustment (late fee)", entries[2].Description())
+}
+
+func TestSettleLedgerHistory(t *testing.T) {
+	leagues := map[int32]db.League{
+		2023: {ID: "league2023", Year: 2023, Status: domain.LeagueStatusComplete, FirstPlace: "user1", SecondPlace: "user2", ThirdPlace: "user3"},
+		2024: {ID: "league2024", Year: 2024, Status: domain.LeagueStatusInProgress},
+	}
+
+	var inserted []db.InsertLedgerEntryParams
+	var audited []db.InsertAdminAuditLogParams
+	mockDB := &dependency.MockDatabase{
+		GetLeagueYearsFunc: func(ctx context.Context) ([]int32, error) {
+			return []int32{2023, 2024}, nil
+		},
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return leagues[year], nil
+		},
+		GetPayoutRulesByYearFunc: func(ctx context.Context, year int32) (db.SeasonPayoutRule, error) {
+			return db.SeasonPayoutRule{}, pgx.ErrNoRows
+		},
+		GetMatchupsByYearFunc: func(ctx context.Context, year int32) ([]db.Matchup, error) {
+			return []db.Matchup{
+				{Year: year, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 100.0},
+			}, nil
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			inserted = append(inserted, arg)
+			return nil
+		},
+		InsertAdminAuditLogFunc: func(ctx context.Context, arg db.InsertAdminAuditLogParams) error {
+			audited = append(audited, arg)
+			return nil
+		},
+	}
+
+	i := newTestInteractor(mockDB, &dependency.MockSleeperClient{})
+	require.NoError(t, i.SettleLedgerHistory(context.Background(), "commish"))
+
+	years := make(map[int32]int)
+	for _, e := range inserted {
+		years[e.Year]++
+	}
+	// Two buy-ins and a weekly high score each season, plus the podium of the completed season
+	assert.Equal(t, map[int32]int{2023: 6, 2024: 3}, years)
+
+	require.Len(t, audited, 1)
+	assert.Equal(t, domain.AdminActionSettleLedger, audited[0].Action)
+	assert.Equal(t, "settled the ledger for 2 seasons", audited[0].Details)
+}
+
+func TestAdjustLedger_CoOwnerAdjustsOwnersLedger(t *testing.T) {
+	var inserted []db.InsertLedgerEntryParams
+	mockDB := &dependency.MockDatabase{
+		GetFranchiseByUserIDFunc: func(ctx context.Context, userID string) (db.Franchise, error) {
+			return db.Franchise{ID: 1, OwnerID: "owner"}, nil
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			inserted = append(inserted, arg)
+			return nil
+		},
+	}
+
+	i := newTestInteractor(mockDB, nil)
+	err := i.AdjustLedger(context.Background(), "commish", LedgerAdjustment{Year: 2024, UserID: "coowner", Amount: -10, Note: "late fee"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []db.InsertLedgerEntryParams{
+		{Year: 2024, UserID: "owner", EntryType: domain.LedgerEntryTypeAdjustment, Amount: -10, Note: "late fee"},
+	}, inserted)
+}
+
+func TestSetPayoutRules_ResettlesStartedSeasons(t *testing.T) {
+	rules := domain.PayoutRules{Year: 2024, BuyIn: 150, WeeklyHighScore: 25, FirstPlace: 900, SecondPlace: 450, ThirdPlace: 150}
+
+	var upserted db.UpsertPayoutRulesParams
+	var inserted []db.InsertLedgerEntryParams
+	mockDB := &dependency.MockDatabase{
+		UpsertPayoutRulesFunc: func(ctx context.Context, arg db.UpsertPayoutRulesParams) error {
+			upserted = arg
+			return nil
+		},
+		GetPayoutRulesByYearFunc: func(ctx context.Context, year int32) (db.SeasonPayoutRule, error) {
+			return db.SeasonPayoutRule{Year: upserted.Year, BuyIn: upserted.BuyIn, WeeklyHighScore: upserted.WeeklyHighScore}, nil
+		},
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{ID: "league2024", Year: year, Status: domain.LeagueStatusInProgress}, nil
+		},
+		GetMatchupsByYearFunc: func(ctx context.Context, year int32) ([]db.Matchup, error) {
+			return []db.Matchup{{Year: year, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 100.0}}, nil
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			inserted = append(inserted, arg)
+			return nil
+		},
+	}
+
+	i := newTestInteractor(mockDB, &dependency.MockSleeperClient{})
+	require.NoError(t, i.SetPayoutRules(context.Background(), "commish", rules))
+
+	assert.Equal(t, int32(150), upserted.BuyIn)
+	require.Len(t, inserted, 3)
+	assert.Equal(t, int32(-150), inserted[0].Amount)
+	assert.Equal(t, int32(25), inserted[2].Amount)
+}
+
+func TestSetPayoutRules_FutureSeason(t *testing.T) {
+	mockDB := &dependency.MockDatabase{
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{}, pgx.ErrNoRows
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			t.Fatal("a season that hasn't started has no ledger to settle")
+			return nil
+		},
+	}
+
+	i := newTestInteractor(mockDB, nil)
+	assert.NoError(t, i.SetPayoutRules(context.Background(), "commish", domain.PayoutRules{Year: 2030, BuyIn: 150}))
+}
+
+func TestSettleSeasonLedger_ReplacesMovedPayouts(t *testing.T) {
+	matchups := []db.Matchup{
+		{Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 100.0},
+	}
+
+	// The ledger keeps one entry per user, type and week, like the unique index, plus every adjustment
+	ledger := map[string]db.InsertLedgerEntryParams{
+		"adjustment": {Year: 2024, UserID: "user2", EntryType: domain.LedgerEntryTypeAdjustment, Amount: -5},
+	}
+	mockDB := &dependency.MockDatabase{
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{ID: "league2024", Year: year, Status: domain.LeagueStatusInProgress}, nil
+		},
+		GetPayoutRulesByYearFunc: func(ctx context.Context, year int32) (db.SeasonPayoutRule, error) {
+			return db.SeasonPayoutRule{}, pgx.ErrNoRows
+		},
+		GetMatchupsByYearFunc: func(ctx context.Context, year int32) ([]db.Matchup, error) {
+			return matchups, nil
+		},
+		DeleteSeasonPayoutsFunc: func(ctx context.Context, year int32) error {
+			for key, e := range ledger {
+				if e.Year == year && e.EntryType != domain.LedgerEntryTypeBuyIn && e.EntryType != domain.LedgerEntryTypeAdjustment {
+					delete(ledger, key)
+				}
+			}
+			return nil
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			ledger[fmt.Sprintf("%s/%s/%d", arg.UserID, arg.EntryType, arg.Week)] = arg
+			return nil
+		},
+	}
+	i := newTestInteractor(mockDB, &dependency.MockSleeperClient{})
+	ctx := context.Background()
+
+	require.NoError(t, i.SettleSeasonLedger(ctx, 2024))
+	require.Contains(t, ledger, "user1/WEEKLY_HIGH_SCORE/1")
+
+	// A stat correction makes user2 the week's high scorer
+	matchups[0].AwayScore = 130.0
+	require.NoError(t, i.SettleSeasonLedger(ctx, 2024))
+
+	assert.NotContains(t, ledger, "user1/WEEKLY_HIGH_SCORE/1", "the old high scorer is no longer paid")
+	assert.Contains(t, ledger, "user2/WEEKLY_HIGH_SCORE/1")
+	assert.Contains(t, ledger, "user1/BUY_IN/0")
+	assert.Contains(t, ledger, "adjustment")
+}
+
+func TestSettleUnsettledSeasons(t *testing.T) {
+	var inserted []db.InsertLedgerEntryParams
+	mockDB := &dependency.MockDatabase{
+		GetLeagueYearsFunc: func(ctx context.Context) ([]int32, error) {
+			return []int32{2022, 2023, 2024}, nil
+		},
+		GetSettledLedgerYearsFunc: func(ctx context.Context) ([]int32, error) {
+			return []int32{2024}, nil
+		},
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{ID: fmt.Sprintf("league%d", year), Year: year, Status: domain.LeagueStatusInProgress}, nil
+		},
+		GetPayoutRulesByYearFunc: func(ctx context.Context, year int32) (db.SeasonPayoutRule, error) {
+			return db.SeasonPayoutRule{}, pgx.ErrNoRows
+		},
+		GetMatchupsByYearFunc: func(ctx context.Context, year int32) ([]db.Matchup, error) {
+			return []db.Matchup{{Year: year, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 100.0}}, nil
+		},
+		InsertLedgerEntryFunc: func(ctx context.Context, arg db.InsertLedgerEntryParams) error {
+			inserted = append(inserted, arg)
+			return nil
+		},
+	}
+
+	i := newTestInteractor(mockDB, &dependency.MockSleeperClient{})
+	years, err := i.SettleUnsettledSeasons(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{2022, 2023}, years, "seasons already in the ledger aren't settled again")
+	for _, e := range inserted {
+		assert.NotEqual(t, int32(2024), e.Year)
+	}
+}
@@ -2,16 +2,40 @@ package interactor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/mail"
 
 	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// ErrSleeperUserClaimed is returned when linking a Sleeper account that another Discord user has already claimed
+	ErrSleeperUserClaimed = errors.New("this Sleeper account has already been claimed by another Discord user")
+	// ErrDiscordUserLinked is returned when linking a Discord user that is already linked to a Sleeper account
+	ErrDiscordUserLinked = errors.New("this Discord user is already linked to a Sleeper account")
+	// ErrNotLinked is returned when a Discord user hasn't linked a Sleeper account yet
+	ErrNotLinked = errors.New("this Discord user is not linked to a Sleeper account")
 )
 
 type OnboardingInteractor interface {
 	GetAvailableSleeperUsers(ctx context.Context) ([]AvailableSleeperUser, error)
 	LinkDiscordToSleeperUser(ctx context.Context, discordID, sleeperUserID string) error
 	IsUserOnboarded(ctx context.Context, discordID string) (bool, error)
+	GetLinkedUser(ctx context.Context, discordID string) (domain.User, error)
+	UnlinkDiscordUser(ctx context.Context, discordID string) (domain.User, error)
+	SetEmailForDiscordUser(ctx context.Context, discordID, email string) (domain.User, error)
+	CompleteOnboarding(ctx context.Context, discordID string) error
+	GetTeamName(ctx context.Context, userID string) (string, error)
+	RecordWelcomeMessage(ctx context.Context, discordID, channelID, messageID string) error
+	GetWelcomeMessages(ctx context.Context) (domain.WelcomeMessages, error)
+	RemoveWelcomeMessage(ctx context.Context, discordID string) (*domain.WelcomeMessage, error)
+	RecordOnboardingReminder(ctx context.Context, discordID string) error
 }
 
 type AvailableSleeperUser struct {
@@ -102,17 +126,16 @@ func (i *interactor) LinkDiscordToSleeperUser(ctx context.Context, discordID, sl
 	}
 
 	if isClaimed {
-		return fmt.Errorf("this Sleeper account has already been claimed by another Discord user")
+		return ErrSleeperUserClaimed
 	}
 
-	// Check if Discord user is already linked to another account
-	isOnboarded, err := i.DB.IsUserOnboarded(ctx, discordID)
-	if err != nil {
-		return fmt.Errorf("failed to check onboarding status: %w", err)
+	// Check if Discord user is already linked to another account, whether or not they finished onboarding
+	_, err = i.GetLinkedUser(ctx, discordID)
+	if err == nil {
+		return ErrDiscordUserLinked
 	}
-
-	if isOnboarded {
-		return fmt.Errorf("this Discord user is already linked to a Sleeper account")
+	if !errors.Is(err, ErrNotLinked) {
+		return fmt.Errorf("failed to check linked account: %w", err)
 	}
 
 	// Attempt to link the accounts
@@ -127,7 +150,131 @@ func (i *interactor) LinkDiscordToSleeperUser(ctx context.Context, discordID, sl
 	return nil
 }
 
+// CompleteOnboarding marks a Discord user's linked Sleeper account as onboarded, once they are set up in Discord
+func (i *interactor) CompleteOnboarding(ctx context.Context, discordID string) error {
+	if err := i.DB.CompleteUserOnboarding(ctx, discordID); err != nil {
+		return fmt.Errorf("failed to complete onboarding for Discord user %s: %w", discordID, err)
+	}
+	return nil
+}
+
+// GetTeamName retrieves the name of the franchise a user manages, falling back to the team name
+// they have set in Sleeper and then their display name
+func (i *interactor) GetTeamName(ctx context.Context, userID string) (string, error) {
+	// Co-owners share their franchise's name
+	if franchise, err := i.DB.GetFranchiseByUserID(ctx, userID); err == nil && franchise.Name != "" {
+		return franchise.Name, nil
+	}
+
+	user, err := i.SleeperClient.GetUser(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Sleeper user %s: %w", userID, err)
+	}
+	if teamName := user.TeamName(); teamName != "" {
+		return teamName, nil
+	}
+	return user.DisplayName, nil
+}
+
 // IsUserOnboarded checks if a Discord user has already completed the onboarding process
 func (i *interactor) IsUserOnboarded(ctx context.Context, discordID string) (bool, error) {
 	return i.DB.IsUserOnboarded(ctx, discordID)
 }
+
+// GetLinkedUser retrieves the Sleeper user linked to a Discord user, or ErrNotLinked if there isn't one
+func (i *interactor) GetLinkedUser(ctx context.Context, discordID string) (domain.User, error) {
+	user, err := i.DB.GetUserByDiscordID(ctx, discordID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.User{}, ErrNotLinked
+	}
+	if err != nil {
+		return domain.User{}, fmt.Errorf("failed to get user for Discord ID %s: %w", discordID, err)
+	}
+	return converters.UserFromDB(user), nil
+}
+
+// UnlinkDiscordUser removes the link between a Discord user and their Sleeper account so either can be linked again.
+// It returns the Sleeper user that was unlinked.
+func (i *interactor) UnlinkDiscordUser(ctx context.Context, discordID string) (domain.User, error) {
+	user, err := i.GetLinkedUser(ctx, discordID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if err := i.DB.ClearUserDiscordID(ctx, user.ID); err != nil {
+		return domain.User{}, fmt.Errorf("failed to unlink Sleeper account: %w", err)
+	}
+	return user, nil
+}
+
+// SetEmailForDiscordUser sets the address recap emails are sent to for the Sleeper user linked to a Discord user.
+// An empty email stops their emails.
+func (i *interactor) SetEmailForDiscordUser(ctx context.Context, discordID, email string) (domain.User, error) {
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return domain.User{}, fmt.Errorf("invalid email %q: %w", email, err)
+		}
+	}
+
+	user, err := i.GetLinkedUser(ctx, discordID)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if err := i.DB.UpdateUserEmail(ctx, db.UpdateUserEmailParams{ID: user.ID, Email: email}); err != nil {
+		return domain.User{}, fmt.Errorf("failed to update email: %w", err)
+	}
+
+	user.Email = email
+	return user, nil
+}
+
+// RecordWelcomeMessage tracks the welcome message posted for a Discord user so it can be refreshed,
+// cleaned up once they link their account and used to remind them if they don't
+func (i *interactor) RecordWelcomeMessage(ctx context.Context, discordID, channelID, messageID string) error {
+	err := i.DB.UpsertWelcomeMessage(ctx, db.UpsertWelcomeMessageParams{
+		DiscordID: discordID,
+		ChannelID: channelID,
+		MessageID: messageID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record welcome message for Discord user %s: %w", discordID, err)
+	}
+	return nil
+}
+
+// GetWelcomeMessages retrieves the welcome messages of every Discord user who hasn't linked their account yet
+func (i *interactor) GetWelcomeMessages(ctx context.Context) (domain.WelcomeMessages, error) {
+	messages, err := i.DB.GetWelcomeMessages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get welcome messages: %w", err)
+	}
+	return converters.WelcomeMessagesFromDB(messages), nil
+}
+
+// RemoveWelcomeMessage stops tracking a Discord user's welcome message, returning it so it can be cleaned up.
+// It returns nil if the user has no welcome message.
+func (i *interactor) RemoveWelcomeMessage(ctx context.Context, discordID string) (*domain.WelcomeMessage, error) {
+	message, err := i.DB.GetWelcomeMessageByDiscordID(ctx, discordID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get welcome message for Discord user %s: %w", discordID, err)
+	}
+
+	if err := i.DB.DeleteWelcomeMessage(ctx, discordID); err != nil {
+		return nil, fmt.Errorf("failed to delete welcome message for Discord user %s: %w", discordID, err)
+	}
+
+	welcome := converters.WelcomeMessageFromDB(message)
+	return &welcome, nil
+}
+
+// RecordOnboardingReminder records that a Discord user was reminded to link their account
+func (i *interactor) RecordOnboardingReminder(ctx context.Context, discordID string) error {
+	if err := i.DB.RecordWelcomeReminder(ctx, discordID); err != nil {
+		return fmt.Errorf("failed to record onboarding reminder for Discord user %s: %w", discordID, err)
+	}
+	return nil
+}
@@ -4,125 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 )
 
-// testableOnboardingInteractor allows us to test with mock dependencies
-type testableOnboardingInteractor struct {
-	chain *dependency.TestChain
-}
-
-func (i *testableOnboardingInteractor) GetAvailableSleeperUsers(ctx context.Context) ([]AvailableSleeperUser, error) {
-	// Get users from DB where discord_id is empty
-	users, err := i.chain.DB.GetUsersWithoutDiscordID(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get current league to fetch roster information
-	currentLeague, err := i.chain.DB.GetLatestLeague(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get rosters from Sleeper API for team names
-	rosters, err := i.chain.SleeperClient.GetRostersInLeague(ctx, currentLeague.ID)
-	if err != nil {
-		return nil, err
-	}
-
-	var available []AvailableSleeperUser
-	for _, user := range users {
-		// Get Sleeper user details
-		sleeperUser, err := i.chain.SleeperClient.GetUser(ctx, user.ID)
-		if err != nil {
-			// Log error but continue with other users
-			continue
-		}
-
-		// Find the roster owned by this user
-		var roster sleeper.Roster
-		var found bool
-		for _, r := range rosters {
-			if r.OwnerID == user.ID {
-				roster = r
-				found = true
-				break
-			}
-			// Also check co-owners
-			for _, coOwner := range r.CoOwners {
-				if coOwner == user.ID {
-					roster = r
-					found = true
-					break
-				}
-			}
-			if found {
-				break
-			}
-		}
-
-		// Use team name from Sleeper user metadata, fallback to display name
-		teamName := sleeperUser.TeamName()
-		if teamName == "" && found {
-			teamName = "Team " + string(rune(roster.ID))
-		}
-
-		available = append(available, AvailableSleeperUser{
-			SleeperUserID: user.ID,
-			DisplayName:   sleeperUser.DisplayName,
-			Username:      sleeperUser.Username,
-			TeamName:      teamName,
-			RosterID:      roster.ID,
-		})
-	}
-
-	return available, nil
-}
-
-func (i *testableOnboardingInteractor) LinkDiscordToSleeperUser(ctx context.Context, discordID, sleeperUserID string) error {
-	// First check if the Sleeper user is already claimed
-	isClaimed, err := i.chain.DB.CheckSleeperUserClaimed(ctx, sleeperUserID)
-	if err != nil {
-		return err
-	}
-
-	if isClaimed {
-		return errors.New("this Sleeper account has already been claimed by another Discord user")
-	}
-
-	// Check if Discord user is already linked to another account
-	isOnboarded, err := i.chain.DB.IsUserOnboarded(ctx, discordID)
-	if err != nil {
-		return err
-	}
-
-	if isOnboarded {
-		return errors.New("this Discord user is already linked to a Sleeper account")
-	}
-
-	// Attempt to link the accounts
-	err = i.chain.DB.UpdateUserDiscordID(ctx, db.UpdateUserDiscordIDParams{
-		ID:        sleeperUserID,
-		DiscordID: discordID,
-	})
-	return err
-}
-
-func (i *testableOnboardingInteractor) IsUserOnboarded(ctx context.Context, discordID string) (bool, error) {
-	return i.chain.DB.IsUserOnboarded(ctx, discordID)
-}
-
-func newTestableOnboardingInteractor(chain *dependency.TestChain) *testableOnboardingInteractor {
-	return &testableOnboardingInteractor{chain: chain}
-}
-
 func TestGetAvailableSleeperUsers(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -300,8 +193,7 @@ func TestGetAvailableSleeperUsers(t *testing.T) {
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, mockSleeperClient, nil)
-			interactor := newTestableOnboardingInteractor(chain)
+			interactor := newTestInteractor(mockDB, mockSleeperClient)
 
 			result, err := interactor.GetAvailableSleeperUsers(context.Background())
 
@@ -329,22 +221,22 @@ func TestGetAvailableSleeperUsers(t *testing.T) {
 
 func TestLinkDiscordToSleeperUser(t *testing.T) {
 	tests := []struct {
-		name                 string
-		discordID            string
-		sleeperUserID        string
-		sleeperUserClaimed   bool
-		discordUserOnboarded bool
-		claimedCheckError    error
-		onboardedCheckError  error
-		updateError          error
-		expectedError        string
+		name               string
+		discordID          string
+		sleeperUserID      string
+		sleeperUserClaimed bool
+		discordUserLinked  bool
+		claimedCheckError  error
+		linkedCheckError   error
+		updateError        error
+		expectedError      string
 	}{
 		{
-			name:                 "successful linking",
-			discordID:            "discord123",
-			sleeperUserID:        "sleeper456",
-			sleeperUserClaimed:   false,
-			discordUserOnboarded: false,
+			name:               "successful linking",
+			discordID:          "discord123",
+			sleeperUserID:      "sleeper456",
+			sleeperUserClaimed: false,
+			discordUserLinked:  false,
 		},
 		{
 			name:               "sleeper user already claimed",
@@ -354,12 +246,12 @@ func TestLinkDiscordToSleeperUser(t *testing.T) {
 			expectedError:      "this Sleeper account has already been claimed",
 		},
 		{
-			name:                 "discord user already onboarded",
-			discordID:            "discord123",
-			sleeperUserID:        "sleeper456",
-			sleeperUserClaimed:   false,
-			discordUserOnboarded: true,
-			expectedError:        "this Discord user is already linked",
+			name:               "discord user already linked",
+			discordID:          "discord123",
+			sleeperUserID:      "sleeper456",
+			sleeperUserClaimed: false,
+			discordUserLinked:  true,
+			expectedError:      "this Discord user is already linked",
 		},
 		{
 			name:              "error checking if sleeper user claimed",
@@ -369,21 +261,21 @@ func TestLinkDiscordToSleeperUser(t *testing.T) {
 			expectedError:     "database error",
 		},
 		{
-			name:                "error checking if discord user onboarded",
-			discordID:           "discord123",
-			sleeperUserID:       "sleeper456",
-			sleeperUserClaimed:  false,
-			onboardedCheckError: errors.New("database error"),
-			expectedError:       "database error",
+			name:               "error checking if discord user linked",
+			discordID:          "discord123",
+			sleeperUserID:      "sleeper456",
+			sleeperUserClaimed: false,
+			linkedCheckError:   errors.New("database error"),
+			expectedError:      "database error",
 		},
 		{
-			name:                 "error updating database",
-			discordID:            "discord123",
-			sleeperUserID:        "sleeper456",
-			sleeperUserClaimed:   false,
-			discordUserOnboarded: false,
-			updateError:          errors.New("update failed"),
-			expectedError:        "update failed",
+			name:               "error updating database",
+			discordID:          "discord123",
+			sleeperUserID:      "sleeper456",
+			sleeperUserClaimed: false,
+			discordUserLinked:  false,
+			updateError:        errors.New("update failed"),
+			expectedError:      "update failed",
 		},
 	}
 
@@ -394,14 +286,18 @@ func TestLinkDiscordToSleeperUser(t *testing.T) {
 					assert.Equal(t, tt.sleeperUserID, id)
 					return tt.sleeperUserClaimed, tt.claimedCheckError
 				},
-				IsUserOnboardedFunc: func(ctx context.Context, discordID string) (bool, error) {
-					if tt.claimedCheckError == nil {
-						assert.Equal(t, tt.discordID, discordID)
+				GetUserByDiscordIDFunc: func(ctx context.Context, discordID string) (db.User, error) {
+					assert.Equal(t, tt.discordID, discordID)
+					if tt.linkedCheckError != nil {
+						return db.User{}, tt.linkedCheckError
+					}
+					if !tt.discordUserLinked {
+						return db.User{}, pgx.ErrNoRows
 					}
-					return tt.discordUserOnboarded, tt.onboardedCheckError
+					return db.User{ID: "sleeper789", DiscordID: discordID}, nil
 				},
 				UpdateUserDiscordIDFunc: func(ctx context.Context, arg db.UpdateUserDiscordIDParams) error {
-					if tt.claimedCheckError == nil && tt.onboardedCheckError == nil {
+					if tt.claimedCheckError == nil && tt.linkedCheckError == nil {
 						assert.Equal(t, tt.sleeperUserID, arg.ID)
 						assert.Equal(t, tt.discordID, arg.DiscordID)
 					}
@@ -409,8 +305,7 @@ func TestLinkDiscordToSleeperUser(t *testing.T) {
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, nil, nil)
-			interactor := newTestableOnboardingInteractor(chain)
+			interactor := newTestInteractor(mockDB, nil)
 
 			err := interactor.LinkDiscordToSleeperUser(context.Background(), tt.discordID, tt.sleeperUserID)
 
@@ -462,8 +357,7 @@ func TestIsUserOnboarded(t *testing.T) {
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, nil, nil)
-			interactor := newTestableOnboardingInteractor(chain)
+			interactor := newTestInteractor(mockDB, nil)
 
 			result, err := interactor.IsUserOnboarded(context.Background(), tt.discordID)
 
@@ -477,3 +371,56 @@ func TestIsUserOnboarded(t *testing.T) {
 		})
 	}
 }
+
+func TestWelcomeMessage_ReminderDue(t *testing.T) {
+	welcomed := time.Date(2024, 9, 1, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		message domain.WelcomeMessage
+		now     time.Time
+		want    bool
+	}{
+		{
+			name:    "not due the day after the welcome",
+			message: domain.WelcomeMessage{CreatedAt: welcomed},
+			now:     welcomed.Add(24 * time.Hour),
+			want:    false,
+		},
+		{
+			name:    "due two days after the welcome",
+			message: domain.WelcomeMessage{CreatedAt: welcomed},
+			now:     welcomed.Add(48 * time.Hour),
+			want:    true,
+		},
+		{
+			name:    "due when the daily job runs a little early",
+			message: domain.WelcomeMessage{CreatedAt: welcomed},
+			now:     welcomed.Add(48*time.Hour - time.Minute),
+			want:    true,
+		},
+		{
+			name:    "counts from the last reminder",
+			message: domain.WelcomeMessage{CreatedAt: welcomed, ReminderCount: 1, LastRemindedAt: welcomed.Add(48 * time.Hour)},
+			now:     welcomed.Add(72 * time.Hour),
+			want:    false,
+		},
+		{
+			name:    "stops after the last reminder",
+			message: domain.WelcomeMessage{CreatedAt: welcomed, ReminderCount: domain.MaxOnboardingReminders, LastRemindedAt: welcomed},
+			now:     welcomed.Add(30 * 24 * time.Hour),
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.message.ReminderDue(tt.now))
+		})
+	}
+}
+
+func TestWelcomeMessage_URL(t *testing.T) {
+	message := domain.WelcomeMessage{ChannelID: "channel1", MessageID: "message1"}
+	assert.Equal(t, "https://discord.com/channels/guild1/channel1/message1", message.URL("guild1"))
+}
@@ -0,0 +1,150 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+// sleeperLeagueStatusComplete is the status Sleeper reports once a league's playoffs are finished
+const sleeperLeagueStatusComplete = "complete"
+
+type SeasonInteractor interface {
+	CompleteSeasonIfFinished(ctx context.Context, year int) (bool, error)
+	GetSeasonAwards(ctx context.Context, year int) (*domain.SeasonAwards, error)
+}
+
+// CompleteSeasonIfFinished marks an in-progress league as COMPLETE, recording its podium from the Sleeper
+// winners bracket, once Sleeper reports the league as complete. It returns true only when the league
+// transitions to COMPLETE, so callers can react to the end of the season exactly once.
+func (i *interactor) CompleteSeasonIfFinished(ctx context.Context, year int) (bool, error) {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return false, fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	if league.Status != domain.LeagueStatusInProgress {
+		return false, nil
+	}
+
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+
+	if sleeperLeague.Status != sleeperLeagueStatusComplete {
+		return false, nil
+	}
+
+	if err := i.completeSeason(ctx, league); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// completeSeason marks a league as COMPLETE, recording its podium from the Sleeper winners bracket.
+func (i *interactor) completeSeason(ctx context.Context, league domain.League) error {
+	bracket, err := i.SleeperClient.GetWinnersBracket(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get winners bracket from Sleeper: %w", err)
+	}
+
+	rosters, err := i.SleeperClient.GetRostersInLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get rosters from Sleeper: %w", err)
+	}
+
+	finals, ok := bracket.WithPlacement(1)
+	if !ok {
+		return errors.New("winners bracket has no championship game")
+	}
+	thirdPlaceGame, ok := bracket.WithPlacement(3)
+	if !ok {
+		return errors.New("winners bracket has no third place game")
+	}
+	if finals.Winner == 0 || thirdPlaceGame.Winner == 0 {
+		return errors.New("the championship and third place games haven't been decided yet")
+	}
+
+	err = i.DB.CompleteLeague(ctx, db.CompleteLeagueParams{
+		ID:          league.ID,
+		FirstPlace:  rosters.WithID(finals.Winner).OwnerID,
+		SecondPlace: rosters.WithID(finals.Loser).OwnerID,
+		ThirdPlace:  rosters.WithID(thirdPlaceGame.Winner).OwnerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete league: %w", err)
+	}
+
+	return nil
+}
+
+// GetSeasonAwards builds the end-of-season awards report for a completed league.
+func (i *interactor) GetSeasonAwards(ctx context.Context, year int) (*domain.SeasonAwards, error) {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	if league.Status != domain.LeagueStatusComplete {
+		return nil, fmt.Errorf("league year %d is not complete", year)
+	}
+
+	podium, err := i.getPodium(ctx, league)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine podium for year %d: %w", year, err)
+	}
+
+	matchups, err := i.DB.GetMatchupsByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matchups for year %d: %w", year, err)
+	}
+
+	// Synced playoff games aren't flagged as playoffs, so use the league's playoff start week as well
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+	regularSeason := regularSeasonMatchups(converters.MatchupsFromDB(matchups), sleeperLeague.Settings.PlayoffWeekStart)
+
+	payouts, err := i.GetLedgerBalances(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := i.GetTradeGrades(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := i.GetDraftReport(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	awards := domain.NewSeasonAwards(league.ID, year, regularSeason, podium)
+	awards.Payouts = payouts
+	awards.WorstTrade = trades.Worst()
+	awards.DraftSteal = draft.Steal
+	awards.DraftBust = draft.Bust
+
+	return &awards, nil
+}
+
+// regularSeasonMatchups filters out playoff games, including games on or after the playoff start week.
+// A playoff start week of 0 means it is unknown.
+func regularSeasonMatchups(matchups domain.Matchups, playoffWeekStart int) domain.Matchups {
+	var regularSeason domain.Matchups
+	for _, m := range matchups {
+		if m.IsPlayoff || (playoffWeekStart > 0 && m.Week >= playoffWeekStart) {
+			continue
+		}
+		regularSeason = append(regularSeason, m)
+	}
+	return regularSeason
+}
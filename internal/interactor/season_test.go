@@ -0,0 +1,21 @@
+package interactor
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegularSeasonMatchups(t *testing.T) {
+	matchups := domain.Matchups{
+		{Week: 13},
+		{Week: 14},
+		{Week: 15},
+		{Week: 5, IsPlayoff: true},
+	}
+
+	assert.Len(t, regularSeasonMatchups(matchups, 15), 2)
+	assert.Len(t, regularSeasonMatchups(matchups, 0), 3, "unknown playoff start week only uses the playoff flag")
+}
@@ -2,20 +2,147 @@ package interactor
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
 	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
 	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 )
 
 type StatsInteractor interface {
 	GetCareerStatsForDiscordUser(ctx context.Context, userID string) (domain.CareerStats, error)
+	GetCareerStatsForUser(ctx context.Context, userID string) (domain.CareerStats, error)
+	GetCareerStatsForFranchise(ctx context.Context, franchiseID int) (domain.CareerStats, error)
 }
 
 func (i *interactor) GetCareerStatsForDiscordUser(ctx context.Context, userID string) (domain.CareerStats, error) {
-	stat, err := i.DB.GetCareerStatsByDiscordID(ctx, userID)
+	user, err := i.DB.GetUserByDiscordID(ctx, userID)
 	if err != nil {
 		return domain.CareerStats{}, err
 	}
+	return i.GetCareerStatsForUser(ctx, user.ID)
+}
+
+// GetCareerStatsForUser retrieves career stats by Sleeper user ID, for managers who aren't linked to a Discord account.
+// A manager who has co-owned a franchise gets the stats of every team they played for, counting each season
+// under whoever owned their team that season, so seasons they owned a team of their own are kept.
+func (i *interactor) GetCareerStatsForUser(ctx context.Context, userID string) (domain.CareerStats, error) {
+	franchises, err := i.GetFranchises(ctx)
+	if err != nil {
+		return domain.CareerStats{}, err
+	}
+
+	dbSeasons, err := i.DB.GetFranchiseSeasonsByUserID(ctx, userID)
+	if err != nil {
+		return domain.CareerStats{}, fmt.Errorf("failed to get franchise seasons for user %s: %w", userID, err)
+	}
+	seasons := converters.FranchiseOwnersFromDB(dbSeasons)
+
+	var stats domain.CareerStats
+	if coOwnedAny(seasons, userID) {
+		user, err := i.DB.GetUserByID(ctx, userID)
+		if err != nil {
+			return domain.CareerStats{}, fmt.Errorf("failed to get user %s: %w", userID, err)
+		}
+		stats, err = i.careerStatsForSeasons(ctx, seasons)
+		if err != nil {
+			return domain.CareerStats{}, err
+		}
+		stats.UserID, stats.UserName = user.ID, user.Name
+	} else {
+		// Managers who have only ever owned their teams have every season recorded under their own ID
+		stat, err := i.DB.GetCareerStatsByUserID(ctx, userID)
+		if err != nil {
+			return domain.CareerStats{}, err
+		}
+		stats, err = i.careerStatsWithEarnings(ctx, stat)
+		if err != nil {
+			return domain.CareerStats{}, err
+		}
+	}
+
+	if franchise, ok := franchises.ForUser(userID); ok && len(franchise.Managers) > 1 {
+		stats.FranchiseName = franchise.Name
+		stats.Managers = franchise.ManagerNames()
+	}
+	return stats, nil
+}
+
+// coOwnedAny reports whether a user co-owned their team in any of the seasons, i.e. someone else owned it
+func coOwnedAny(seasons []domain.FranchiseOwner, userID string) bool {
+	for _, season := range seasons {
+		if season.UserID != userID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCareerStatsForFranchise retrieves career stats for a franchise across everyone who has owned it,
+// counting each season under whoever owned the franchise that season.
+func (i *interactor) GetCareerStatsForFranchise(ctx context.Context, franchiseID int) (domain.CareerStats, error) {
+	history, err := i.GetFranchiseHistory(ctx, franchiseID)
+	if err != nil {
+		return domain.CareerStats{}, err
+	}
+	if len(history.Owners) == 0 {
+		return domain.CareerStats{}, fmt.Errorf("no seasons recorded for franchise %d", franchiseID)
+	}
+
+	stats, err := i.careerStatsForSeasons(ctx, history.Owners)
+	if err != nil {
+		return domain.CareerStats{}, err
+	}
+
+	users, err := i.GetUsers(ctx)
+	if err != nil {
+		return domain.CareerStats{}, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	stats.UserID = history.Franchise.OwnerID
+	stats.UserName = history.Franchise.DisplayName()
+	stats.FranchiseName = history.Franchise.DisplayName()
+	stats.Owners = history.OwnerNames(users)
+	return stats, nil
+}
+
+// careerStatsForSeasons counts each season's matchups and earnings for whoever owned the team that season
+func (i *interactor) careerStatsForSeasons(ctx context.Context, seasons []domain.FranchiseOwner) (domain.CareerStats, error) {
+	var matchups domain.Matchups
+	earnings := 0
+	for _, season := range seasons {
+		seasonMatchups, err := i.DB.GetMatchupsByYear(ctx, int32(season.Year))
+		if err != nil {
+			return domain.CareerStats{}, fmt.Errorf("failed to get matchups for %d: %w", season.Year, err)
+		}
+		matchups = append(matchups, converters.MatchupsFromDB(seasonMatchups)...)
+
+		// Earnings come from the ledger of whoever owned the team that season
+		balances, err := i.DB.GetLedgerBalancesByYear(ctx, int32(season.Year))
+		if err != nil {
+			return domain.CareerStats{}, fmt.Errorf("failed to get ledger balances for %d: %w", season.Year, err)
+		}
+		for _, balance := range balances {
+			if balance.UserID == season.UserID {
+				earnings += int(balance.Balance)
+			}
+		}
+	}
+
+	stats := domain.SeasonsCareerStats(seasons, matchups)
+	stats.CareerEarnings = earnings
+	return stats, nil
+}
+
+func (i *interactor) careerStatsWithEarnings(ctx context.Context, stat db.CareerStat) (domain.CareerStats, error) {
+	stats := converters.CareerStatsFromDB(stat)
+
+	// Earnings come from the ledger so each season is valued with the payout rules in effect at the time
+	earnings, err := i.DB.GetCareerEarningsByUserID(ctx, stat.UserID)
+	if err != nil {
+		return domain.CareerStats{}, fmt.Errorf("failed to get career earnings: %w", err)
+	}
+	stats.CareerEarnings = int(earnings)
 
-	return converters.CareerStatsFromDB(stat), nil
+	return stats, nil
 }
@@ -7,30 +7,11 @@ import (
 
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
-	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
 	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 
 	"github.com/stretchr/testify/assert"
 )
 
-// testableStatsInteractor allows us to test with mock dependencies
-type testableStatsInteractor struct {
-	chain *dependency.TestChain
-}
-
-func (i *testableStatsInteractor) GetCareerStatsForDiscordUser(ctx context.Context, userID string) (domain.CareerStats, error) {
-	stat, err := i.chain.DB.GetCareerStatsByDiscordID(ctx, userID)
-	if err != nil {
-		return domain.CareerStats{}, err
-	}
-
-	return converters.CareerStatsFromDB(stat), nil
-}
-
-func newTestableStatsInteractor(chain *dependency.TestChain) *testableStatsInteractor {
-	return &testableStatsInteractor{chain: chain}
-}
-
 func TestGetCareerStatsForDiscordUser(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -216,14 +197,17 @@ func TestGetCareerStatsForDiscordUser(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDB := &dependency.MockDatabase{
-				GetCareerStatsByDiscordIDFunc: func(ctx context.Context, discordID string) (db.CareerStat, error) {
+				GetUserByDiscordIDFunc: func(ctx context.Context, discordID string) (db.User, error) {
 					assert.Equal(t, tt.inputDiscordID, discordID)
-					return tt.mockCareerStat, tt.dbError
+					return db.User{ID: tt.mockCareerStat.UserID, DiscordID: discordID}, tt.dbError
+				},
+				GetCareerStatsByUserIDFunc: func(ctx context.Context, userID string) (db.CareerStat, error) {
+					assert.Equal(t, tt.mockCareerStat.UserID, userID)
+					return tt.mockCareerStat, nil
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, nil, nil)
-			interactor := newTestableStatsInteractor(chain)
+			interactor := newTestInteractor(mockDB, nil)
 
 			result, err := interactor.GetCareerStatsForDiscordUser(context.Background(), tt.inputDiscordID)
 
@@ -245,19 +229,18 @@ func TestGetCareerStatsForDiscordUser_ContextCancellation(t *testing.T) {
 	cancel() // Cancel immediately
 
 	mockDB := &dependency.MockDatabase{
-		GetCareerStatsByDiscordIDFunc: func(ctx context.Context, discordID string) (db.CareerStat, error) {
+		GetUserByDiscordIDFunc: func(ctx context.Context, discordID string) (db.User, error) {
 			// Check if context was cancelled
 			select {
 			case <-ctx.Done():
-				return db.CareerStat{}, ctx.Err()
+				return db.User{}, ctx.Err()
 			default:
-				return db.CareerStat{}, nil
+				return db.User{ID: "user456"}, nil
 			}
 		},
 	}
 
-	chain := dependency.NewTestChain(mockDB, nil, nil)
-	interactor := newTestableStatsInteractor(chain)
+	interactor := newTestInteractor(mockDB, nil)
 
 	result, err := interactor.GetCareerStatsForDiscordUser(ctx, "discord123")
 
@@ -269,14 +252,13 @@ func TestGetCareerStatsForDiscordUser_ContextCancellation(t *testing.T) {
 func TestGetCareerStatsForDiscordUser_EmptyDiscordID(t *testing.T) {
 	// Test behavior with empty Discord ID
 	mockDB := &dependency.MockDatabase{
-		GetCareerStatsByDiscordIDFunc: func(ctx context.Context, discordID string) (db.CareerStat, error) {
+		GetUserByDiscordIDFunc: func(ctx context.Context, discordID string) (db.User, error) {
 			assert.Equal(t, "", discordID)
-			return db.CareerStat{}, errors.New("invalid discord ID")
+			return db.User{}, errors.New("invalid discord ID")
 		},
 	}
 
-	chain := dependency.NewTestChain(mockDB, nil, nil)
-	interactor := newTestableStatsInteractor(chain)
+	interactor := newTestInteractor(mockDB, nil)
 
 	result, err := interactor.GetCareerStatsForDiscordUser(context.Background(), "")
 
@@ -284,3 +266,52 @@ func TestGetCareerStatsForDiscordUser_EmptyDiscordID(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid discord ID")
 	assert.Equal(t, domain.CareerStats{}, result)
 }
+
+func TestGetCareerStatsForUser_CoOwnerKeepsSeasonsOfTheirOwnTeam(t *testing.T) {
+	matchups := map[int32][]db.Matchup{
+		// 2022: coowner1 owned a team of their own and had the week's high score
+		2022: {
+			{Year: 2022, Week: 1, HomeUserID: "coowner1", AwayUserID: "owner2", HomeScore: 120, AwayScore: 100},
+			{Year: 2022, Week: 1, HomeUserID: "owner1", AwayUserID: "other", HomeScore: 110, AwayScore: 90},
+		},
+		// 2023: coowner1 co-owned owner1's team, whose matchups are recorded under owner1
+		2023: {
+			{Year: 2023, Week: 1, HomeUserID: "owner1", AwayUserID: "owner2", HomeScore: 90, AwayScore: 110},
+		},
+	}
+	mockDB := &dependency.MockDatabase{
+		GetFranchiseSeasonsByUserIDFunc: func(ctx context.Context, userID string) ([]db.FranchiseOwner, error) {
+			return []db.FranchiseOwner{
+				{FranchiseID: 3, Year: 2022, UserID: "coowner1"},
+				{FranchiseID: 1, Year: 2023, UserID: "owner1"},
+			}, nil
+		},
+		GetUserByIDFunc: func(ctx context.Context, id string) (db.User, error) {
+			return db.User{ID: id, Name: "Jane Smith"}, nil
+		},
+		GetMatchupsByYearFunc: func(ctx context.Context, year int32) ([]db.Matchup, error) {
+			return matchups[year], nil
+		},
+		GetLedgerBalancesByYearFunc: func(ctx context.Context, year int32) ([]db.GetLedgerBalancesByYearRow, error) {
+			return []db.GetLedgerBalancesByYearRow{
+				{UserID: "coowner1", Balance: 25},
+				{UserID: "owner1", Balance: -100 * (year - 2022)},
+			}, nil
+		},
+		GetCareerStatsByUserIDFunc: func(ctx context.Context, userID string) (db.CareerStat, error) {
+			t.Fatalf("co-owners' stats aren't aliased to a single user, got a lookup for %s", userID)
+			return db.CareerStat{}, nil
+		},
+	}
+	i := newTestInteractor(mockDB, nil)
+
+	stats, err := i.GetCareerStatsForUser(context.Background(), "coowner1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "coowner1", stats.UserID)
+	assert.Equal(t, "Jane Smith", stats.UserName)
+	assert.Equal(t, int64(2), stats.SeasonsPlayed)
+	assert.Equal(t, "1-1", stats.RegularSeasonRecord)
+	assert.Equal(t, int64(1), stats.WeeklyHighScores)
+	assert.Equal(t, 25-100, stats.CareerEarnings, "each season's earnings come from whoever owned the team that season")
+}
@@ -0,0 +1,254 @@
+package interactor_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/internal/format"
+	"github.com/sam-maryland/any-given-sunday/internal/interactor"
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper/sleepertest"
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureLeagueID is the synthetic league in testdata/sleeper: four teams, two weeks into the 2025 season
+const fixtureLeagueID = "1180180342143975424"
+
+// leagueDB keeps what the weekly sync records in memory, answering the queries the weekly recap makes
+type leagueDB struct {
+	league   db.League
+	users    map[string]db.User
+	matchups []db.Matchup
+	scores   map[string]db.PlayerScore
+}
+
+func newLeagueDB(league db.League) *leagueDB {
+	return &leagueDB{league: league, users: make(map[string]db.User), scores: make(map[string]db.PlayerScore)}
+}
+
+func (l *leagueDB) mock() *dependency.MockDatabase {
+	return &dependency.MockDatabase{
+		GetLatestLeagueFunc: func(ctx context.Context) (db.League, error) {
+			return l.league, nil
+		},
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			if year != l.league.Year {
+				return db.League{}, pgx.ErrNoRows
+			}
+			return l.league, nil
+		},
+		GetPayoutRulesByYearFunc: func(ctx context.Context, year int32) (db.SeasonPayoutRule, error) {
+			return db.SeasonPayoutRule{Year: year, WeeklyHighScore: 25}, nil
+		},
+		InsertUserIfMissingFunc: func(ctx context.Context, arg db.InsertUserIfMissingParams) error {
+			if _, ok := l.users[arg.ID]; !ok {
+				l.users[arg.ID] = db.User{ID: arg.ID, Name: arg.Name}
+			}
+			return nil
+		},
+		GetUserByIDFunc: func(ctx context.Context, id string) (db.User, error) {
+			user, ok := l.users[id]
+			if !ok {
+				return db.User{}, pgx.ErrNoRows
+			}
+			return user, nil
+		},
+		GetUsersFunc: func(ctx context.Context) ([]db.User, error) {
+			var users []db.User
+			for _, u := range l.users {
+				users = append(users, u)
+			}
+			return users, nil
+		},
+		GetMatchupByYearWeekUsersFunc: func(ctx context.Context, arg db.GetMatchupByYearWeekUsersParams) (db.Matchup, error) {
+			for _, m := range l.matchups {
+				if m.Year == arg.Year && m.Week == arg.Week && m.HomeUserID == arg.HomeUserID && m.AwayUserID == arg.AwayUserID {
+					return m, nil
+				}
+			}
+			return db.Matchup{}, pgx.ErrNoRows
+		},
+		InsertMatchupFunc: func(ctx context.Context, arg db.InsertMatchupParams) (pgtype.UUID, error) {
+			l.matchups = append(l.matchups, db.Matchup{
+				Year:         arg.Year,
+				Week:         arg.Week,
+				IsPlayoff:    arg.IsPlayoff,
+				PlayoffRound: arg.PlayoffRound,
+				HomeUserID:   arg.HomeUserID,
+				AwayUserID:   arg.AwayUserID,
+				HomeSeed:     arg.HomeSeed,
+				AwaySeed:     arg.AwaySeed,
+				HomeScore:    arg.HomeScore,
+				AwayScore:    arg.AwayScore,
+			})
+			return pgtype.UUID{}, nil
+		},
+		UpdateMatchupScoresFunc: func(ctx context.Context, arg db.UpdateMatchupScoresParams) error {
+			return l.setScores(arg.Year, arg.Week, arg.HomeUserID, arg.AwayUserID, arg.HomeScore, arg.AwayScore, false)
+		},
+		CorrectMatchupScoresFunc: func(ctx context.Context, arg db.CorrectMatchupScoresParams) error {
+			return l.setScores(arg.Year, arg.Week, arg.HomeUserID, arg.AwayUserID, arg.HomeScore, arg.AwayScore, true)
+		},
+		GetMatchupsByYearFunc: func(ctx context.Context, year int32) ([]db.Matchup, error) {
+			var matchups []db.Matchup
+			for _, m := range l.matchups {
+				if m.Year == year {
+					matchups = append(matchups, m)
+				}
+			}
+			return matchups, nil
+		},
+		GetLatestCompletedWeekFunc: func(ctx context.Context, year int32) (int32, error) {
+			var latest int32
+			for _, m := range l.matchups {
+				if m.Year == year && !m.IsPlayoff.Bool {
+					latest = max(latest, m.Week)
+				}
+			}
+			return latest, nil
+		},
+		GetWeeklyHighScoreFunc: func(ctx context.Context, arg db.GetWeeklyHighScoreParams) (db.GetWeeklyHighScoreRow, error) {
+			var high db.GetWeeklyHighScoreRow
+			found := false
+			for _, m := range l.matchups {
+				if m.Year != arg.Year || m.Week != arg.Week || m.IsPlayoff.Bool {
+					continue
+				}
+				row := db.GetWeeklyHighScoreRow{WinnerUserID: m.AwayUserID, WinningScore: m.AwayScore, Year: m.Year, Week: m.Week}
+				if m.HomeScore > m.AwayScore {
+					row.WinnerUserID, row.WinningScore = m.HomeUserID, m.HomeScore
+				}
+				if !found || row.WinningScore > high.WinningScore {
+					high, found = row, true
+				}
+			}
+			if !found {
+				return db.GetWeeklyHighScoreRow{}, pgx.ErrNoRows
+			}
+			return high, nil
+		},
+		UpsertPlayerScoreFunc: func(ctx context.Context, arg db.UpsertPlayerScoreParams) error {
+			l.scores[fmt.Sprintf("%d/%d/%s", arg.Year, arg.Week, arg.PlayerID)] = db.PlayerScore{
+				Year:        arg.Year,
+				Week:        arg.Week,
+				PlayerID:    arg.PlayerID,
+				FranchiseID: arg.FranchiseID,
+				UserID:      arg.UserID,
+				Points:      arg.Points,
+				Started:     arg.Started,
+			}
+			return nil
+		},
+		GetPlayerScoresByYearFunc: func(ctx context.Context, year int32) ([]db.PlayerScore, error) {
+			var scores []db.PlayerScore
+			for _, s := range l.scores {
+				if s.Year == year {
+					scores = append(scores, s)
+				}
+			}
+			sort.Slice(scores, func(i, j int) bool { return scores[i].Week < scores[j].Week })
+			return scores, nil
+		},
+	}
+}
+
+func (l *leagueDB) setScores(year, week int32, homeUserID, awayUserID string, homeScore, awayScore float64, corrected bool) error {
+	for idx, m := range l.matchups {
+		if m.Year == year && m.Week == week && m.HomeUserID == homeUserID && m.AwayUserID == awayUserID {
+			l.matchups[idx].HomeScore, l.matchups[idx].AwayScore = homeScore, awayScore
+			l.matchups[idx].ScoresCorrected = l.matchups[idx].ScoresCorrected || corrected
+			return nil
+		}
+	}
+	return pgx.ErrNoRows
+}
+
+// newFixtureChain creates a dependency chain on the fixture league, with an empty database for the 2025 season
+func newFixtureChain(t *testing.T, wrap func(sleeper.ISleeperClient) sleeper.ISleeperClient) (*dependency.Chain, *leagueDB, *sleepertest.Server) {
+	server := sleepertest.NewServer(t, os.DirFS("testdata/sleeper"))
+	database := newLeagueDB(db.League{ID: fixtureLeagueID, Year: 2025, Status: domain.LeagueStatusInProgress})
+
+	var client sleeper.ISleeperClient = server.Client()
+	if wrap != nil {
+		client = wrap(client)
+	}
+	return &dependency.Chain{DB: database.mock(), SleeperClient: client}, database, server
+}
+
+func TestSyncAndRecap_FixtureLeague(t *testing.T) {
+	ctx := context.Background()
+	chain, database, _ := newFixtureChain(t, nil)
+	i := interactor.NewInteractor(chain)
+
+	require.NoError(t, i.SyncLatestData(ctx, 2025))
+
+	// Weeks 1 and 2 are over, week 3 is being played
+	assert.Len(t, database.matchups, 4)
+	assert.Len(t, database.scores, 16)
+	assert.Len(t, database.users, 4)
+
+	summary, err := i.GenerateWeeklySummary(ctx, 2025)
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.Week)
+	assert.Equal(t, "⏳ 1 week behind", summary.DataSyncStatus)
+
+	users, err := i.GetUsers(ctx)
+	require.NoError(t, err)
+	recap := format.WeeklySummary(summary, users)
+
+	assert.Contains(t, recap, "📊 **Week 2 Summary (2025)** 📊")
+	assert.Contains(t, recap, "🏆 **High Score Winner**: Bob - 140.25 points")
+	assert.Contains(t, recap, "$25 weekly high score bonus")
+	// Alice and Bob are both 1-1, and Alice won their week 1 matchup
+	assert.Contains(t, recap, "1. Carol (2-0) 🥇\n2. Alice (1-1) 🥈\n3. Bob (1-1) 🥉\n4. Dave (0-2)\n")
+}
+
+func TestSyncAndRecap_CachesCompletedWeeks(t *testing.T) {
+	ctx := context.Background()
+	chain, database, server := newFixtureChain(t, func(c sleeper.ISleeperClient) sleeper.ISleeperClient {
+		return sleeper.NewCachingClient(c, nil)
+	})
+	i := interactor.NewInteractor(chain)
+
+	require.NoError(t, i.SyncLatestData(ctx, 2025))
+	require.NoError(t, i.SyncLatestData(ctx, 2025))
+
+	assert.Len(t, database.matchups, 4, "syncing again doesn't duplicate matchups")
+	assert.Equal(t, 1, server.Requests("league/"+fixtureLeagueID+"/matchups/1"))
+	assert.Equal(t, 1, server.Requests("league/"+fixtureLeagueID+"/matchups/2"))
+	assert.Equal(t, 1, server.Requests("state/nfl"))
+}
+
+func TestSyncAndRecap_KeepsCorrectedScores(t *testing.T) {
+	ctx := context.Background()
+	chain, database, _ := newFixtureChain(t, nil)
+	i := interactor.NewInteractor(chain)
+
+	require.NoError(t, i.SyncLatestData(ctx, 2025))
+
+	m := database.matchups[0]
+	correction := interactor.MatchupCorrection{
+		Year:       int(m.Year),
+		Week:       int(m.Week),
+		HomeUserID: m.HomeUserID,
+		AwayUserID: m.AwayUserID,
+		HomeScore:  m.HomeScore + 10,
+		AwayScore:  m.AwayScore,
+	}
+	require.NoError(t, i.CorrectMatchup(ctx, "commish", correction))
+
+	require.NoError(t, i.SyncLatestData(ctx, 2025))
+
+	assert.Equal(t, correction.HomeScore, database.matchups[0].HomeScore, "the sync doesn't undo the correction")
+	assert.True(t, database.matchups[0].ScoresCorrected)
+}
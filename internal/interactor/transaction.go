@@ -0,0 +1,343 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maxTransactionWeek is the last week of a season that Sleeper records transactions for
+const maxTransactionWeek = 18
+
+type TransactionInteractor interface {
+	SyncTransactions(ctx context.Context, year int) error
+	GetTransactions(ctx context.Context, year int) (domain.Transactions, error)
+	GetUnpostedTransactions(ctx context.Context, year int) (domain.Transactions, error)
+	HasPostedTransactions(ctx context.Context, year int) (bool, error)
+	MarkTransactionPosted(ctx context.Context, transactionID string) error
+	GetTradeGrades(ctx context.Context, year int) (domain.TradeGrades, error)
+	GetTradeGradesForDiscordUser(ctx context.Context, discordID string, year int) (domain.TradeGrades, error)
+}
+
+// SyncTransactions records the season's completed trades, waiver claims and free agent moves from Sleeper.
+// Transactions that are already recorded are left untouched, so this can run as often as needed.
+func (i *interactor) SyncTransactions(ctx context.Context, year int) error {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	// Seasons in progress only have transactions up to the current week
+	lastWeek := maxTransactionWeek
+	if league.Status == domain.LeagueStatusInProgress {
+		nflState, err := i.SleeperClient.GetNFLState(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get NFL state: %w", err)
+		}
+		lastWeek = min(max(nflState.Week, 1), maxTransactionWeek)
+	}
+
+	rosters, err := i.SleeperClient.GetRostersInLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get rosters from Sleeper: %w", err)
+	}
+
+	owners, err := i.seasonOwners(ctx, year, rosters)
+	if err != nil {
+		return err
+	}
+
+	for week := 1; week <= lastWeek; week++ {
+		transactions, err := i.SleeperClient.GetTransactions(ctx, league.ID, week)
+		if err != nil {
+			return fmt.Errorf("failed to get week %d transactions from Sleeper: %w", week, err)
+		}
+
+		for _, t := range transactions {
+			// Failed waiver claims and rejected trades never happened
+			if t.Status != sleeper.TransactionStatusComplete {
+				continue
+			}
+			if err := i.recordTransaction(ctx, year, t, owners); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordTransaction records a transaction with the players and draft picks that changed hands.
+// Rosters are matched to the users who owned them that season.
+func (i *interactor) recordTransaction(ctx context.Context, year int, t sleeper.Transaction, owners map[int]string) error {
+	err := i.DB.InsertTransaction(ctx, db.InsertTransactionParams{
+		ID:        t.ID,
+		Year:      int32(year),
+		Week:      int32(t.Week),
+		Type:      t.Type,
+		WaiverBid: int32(t.Settings.WaiverBid),
+		CreatedAt: pgtype.Timestamptz{Time: time.UnixMilli(t.Created), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record transaction %s: %w", t.ID, err)
+	}
+
+	moves := []struct {
+		action  string
+		players map[string]int
+	}{
+		{domain.TransactionActionAdd, t.Adds},
+		{domain.TransactionActionDrop, t.Drops},
+	}
+	for _, move := range moves {
+		for playerID, rosterID := range move.players {
+			err := i.DB.InsertTransactionPlayer(ctx, db.InsertTransactionPlayerParams{
+				TransactionID: t.ID,
+				PlayerID:      playerID,
+				Action:        move.action,
+				FranchiseID:   int32(rosterID),
+				UserID:        owners[rosterID],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record player %s in transaction %s: %w", playerID, t.ID, err)
+			}
+		}
+	}
+
+	for _, pick := range t.DraftPicks {
+		season, err := strconv.Atoi(pick.Season)
+		if err != nil {
+			return fmt.Errorf("invalid draft pick season %q in transaction %s: %w", pick.Season, t.ID, err)
+		}
+		err = i.DB.InsertTransactionDraftPick(ctx, db.InsertTransactionDraftPickParams{
+			TransactionID:       t.ID,
+			Season:              int32(season),
+			Round:               int32(pick.Round),
+			OriginalFranchiseID: int32(pick.RosterID),
+			OriginalUserID:      owners[pick.RosterID],
+			FromUserID:          owners[pick.PreviousOwnerID],
+			ToUserID:            owners[pick.OwnerID],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record draft pick in transaction %s: %w", t.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetTransactions retrieves every recorded transaction of a season, oldest first
+func (i *interactor) GetTransactions(ctx context.Context, year int) (domain.Transactions, error) {
+	transactions, err := i.DB.GetTransactionsByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	return i.transactionsWithPlayers(ctx, year, transactions)
+}
+
+// GetUnpostedTransactions retrieves the season's transactions that haven't been posted to Discord yet, oldest first.
+// Waiver claims in leagues that use FAAB include the claiming team's budget.
+func (i *interactor) GetUnpostedTransactions(ctx context.Context, year int) (domain.Transactions, error) {
+	unposted, err := i.DB.GetUnpostedTransactionsByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unposted transactions: %w", err)
+	}
+	if len(unposted) == 0 {
+		return nil, nil
+	}
+
+	transactions, err := i.transactionsWithPlayers(ctx, year, unposted)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.addFAABBudgets(ctx, year, transactions); err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// HasPostedTransactions reports whether any of the season's transactions have been posted to Discord yet
+func (i *interactor) HasPostedTransactions(ctx context.Context, year int) (bool, error) {
+	posted, err := i.DB.HasPostedTransactions(ctx, int32(year))
+	if err != nil {
+		return false, fmt.Errorf("failed to check for posted transactions: %w", err)
+	}
+	return posted, nil
+}
+
+// MarkTransactionPosted records that a transaction has been posted to Discord
+func (i *interactor) MarkTransactionPosted(ctx context.Context, transactionID string) error {
+	if err := i.DB.MarkTransactionPosted(ctx, transactionID); err != nil {
+		return fmt.Errorf("failed to mark transaction %s posted: %w", transactionID, err)
+	}
+	return nil
+}
+
+// GetTradeGrades grades the season's trades on what the players each side received have scored since, oldest first
+func (i *interactor) GetTradeGrades(ctx context.Context, year int) (domain.TradeGrades, error) {
+	transactions, err := i.GetTransactions(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := i.DB.GetPlayerScoresByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player scores: %w", err)
+	}
+
+	return domain.GradeTrades(transactions, converters.PlayerScoresFromDB(scores)), nil
+}
+
+// GetTradeGradesForDiscordUser grades the season's trades involving the franchise of the Sleeper user linked to a Discord user
+func (i *interactor) GetTradeGradesForDiscordUser(ctx context.Context, discordID string, year int) (domain.TradeGrades, error) {
+	user, err := i.DB.GetUserByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for Discord ID %s: %w", discordID, err)
+	}
+
+	// Co-owners share the trades of the franchise they manage
+	ownerID, err := i.franchiseOwnerID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := i.GetTradeGrades(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+	return grades.ForUser(ownerID), nil
+}
+
+func (i *interactor) transactionsWithPlayers(ctx context.Context, year int, transactions []db.Transaction) (domain.Transactions, error) {
+	players, err := i.DB.GetTransactionPlayersByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction players: %w", err)
+	}
+
+	picks, err := i.DB.GetTransactionDraftPicksByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction draft picks: %w", err)
+	}
+
+	playerIDs := make([]string, 0, len(players))
+	for _, p := range players {
+		playerIDs = append(playerIDs, p.PlayerID)
+	}
+	playerMap, err := i.getPlayers(ctx, playerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.TransactionsFromDB(transactions, players, picks, playerMap), nil
+}
+
+// addFAABBudgets sets the league's FAAB budget and how much the claiming team had spent, including the claim, on each
+// waiver claim. Spending is totalled from the season's recorded waiver bids up to each claim, so older claims show
+// what the team had left at the time.
+func (i *interactor) addFAABBudgets(ctx context.Context, year int, transactions domain.Transactions) error {
+	hasWaivers := false
+	for _, t := range transactions {
+		hasWaivers = hasWaivers || t.Type == domain.TransactionTypeWaiver
+	}
+	if !hasWaivers {
+		return nil
+	}
+
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+	if sleeperLeague.Settings.WaiverBudget == 0 {
+		return nil
+	}
+
+	season, err := i.GetTransactions(ctx, year)
+	if err != nil {
+		return err
+	}
+
+	// Running total of each franchise's winning bids, oldest claim first
+	spent := make(map[int]int)
+	usedAfter := make(map[string]int)
+	for _, t := range season {
+		adds := t.Adds()
+		if t.Type != domain.TransactionTypeWaiver || len(adds) == 0 {
+			continue
+		}
+		spent[adds[0].FranchiseID] += t.WaiverBid
+		usedAfter[t.ID] = spent[adds[0].FranchiseID]
+	}
+
+	for idx, t := range transactions {
+		used, ok := usedAfter[t.ID]
+		if !ok {
+			continue
+		}
+		transactions[idx].FAABBudget = sleeperLeague.Settings.WaiverBudget
+		transactions[idx].FAABUsed = used
+	}
+	return nil
+}
+
+// getPlayers returns players by ID, fetching any that haven't been stored yet from Sleeper.
+// Sleeper asks that its full player list is fetched at most once a day, so it is only fetched when a
+// transaction involves a new player. Players that still can't be found are named after their ID.
+func (i *interactor) getPlayers(ctx context.Context, ids []string) (domain.PlayerMap, error) {
+	stored, err := i.DB.GetPlayersByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+	players := converters.PlayersFromDB(stored)
+
+	var missing []string
+	for _, id := range ids {
+		if _, ok := players[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return players, nil
+	}
+
+	// Player names are only cosmetic, so transactions are still returned if Sleeper can't provide them
+	b, err := i.SleeperClient.FetchAllPlayers(ctx)
+	if err != nil {
+		log.Printf("⚠️  Failed to fetch players from Sleeper: %v", err)
+		return players, nil
+	}
+	all, err := sleeper.DecodePlayers(b)
+	if err != nil {
+		log.Printf("⚠️  %v", err)
+		return players, nil
+	}
+
+	for _, id := range missing {
+		p, ok := all[id]
+		if !ok {
+			continue
+		}
+		player := domain.Player{ID: id, Name: p.Name(), Position: p.Position, Team: p.Team}
+		err := i.DB.UpsertPlayer(ctx, db.UpsertPlayerParams{ID: id, Name: player.Name, Position: player.Position, Team: player.Team})
+		if err != nil {
+			return nil, fmt.Errorf("failed to record player %s: %w", id, err)
+		}
+		players[id] = player
+	}
+
+	return players, nil
+}
@@ -0,0 +1,210 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/internal/dependency"
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTransactions() domain.Transactions {
+	created := time.Date(2024, 10, 2, 12, 0, 0, 0, time.UTC)
+	return converters.TransactionsFromDB(
+		[]db.Transaction{
+			{ID: "trade1", Year: 2024, Week: 5, Type: domain.TransactionTypeTrade, CreatedAt: pgtype.Timestamptz{Time: created, Valid: true}},
+			{ID: "waiver1", Year: 2024, Week: 5, Type: domain.TransactionTypeWaiver, WaiverBid: 44},
+		},
+		[]db.TransactionPlayer{
+			{TransactionID: "trade1", PlayerID: "4866", Action: domain.TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+			{TransactionID: "trade1", PlayerID: "6794", Action: domain.TransactionActionAdd, FranchiseID: 2, UserID: "u2"},
+			{TransactionID: "trade1", PlayerID: "4866", Action: domain.TransactionActionDrop, FranchiseID: 2, UserID: "u2"},
+			{TransactionID: "trade1", PlayerID: "6794", Action: domain.TransactionActionDrop, FranchiseID: 1, UserID: "u1"},
+			{TransactionID: "waiver1", PlayerID: "9999", Action: domain.TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+		},
+		[]db.TransactionDraftPick{
+			{TransactionID: "trade1", Season: 2025, Round: 1, OriginalFranchiseID: 3, OriginalUserID: "u3", FromUserID: "u1", ToUserID: "u2"},
+		},
+		domain.PlayerMap{
+			"4866": {ID: "4866", Name: "Saquon Barkley", Position: "RB", Team: "PHI"},
+			"6794": {ID: "6794", Name: "Justin Jefferson", Position: "WR", Team: "MIN"},
+		},
+	)
+}
+
+func TestTransactionsFromDB(t *testing.T) {
+	transactions := testTransactions()
+
+	require.Len(t, transactions, 2)
+	trade := transactions[0]
+	assert.Equal(t, 2024, trade.Year)
+	assert.Equal(t, time.Date(2024, 10, 2, 12, 0, 0, 0, time.UTC), trade.CreatedAt)
+	assert.Len(t, trade.Players, 4)
+	require.Len(t, trade.DraftPicks, 1)
+	assert.Equal(t, "2025 Round 1 pick", trade.DraftPicks[0].Description())
+
+	waiver := transactions[1]
+	assert.Equal(t, 44, waiver.WaiverBid)
+	require.Len(t, waiver.Players, 1)
+	assert.Equal(t, domain.Player{ID: "9999", Name: "9999"}, waiver.Players[0].Player, "unknown players are named after their ID")
+}
+
+func TestTransaction_Received(t *testing.T) {
+	trade := testTransactions()[0]
+
+	assert.Equal(t, []string{"u1", "u2"}, trade.UserIDs())
+	assert.Len(t, trade.Adds(), 2)
+	assert.Len(t, trade.Drops(), 2)
+
+	players, picks := trade.Received("u1")
+	require.Len(t, players, 1)
+	assert.Equal(t, "Saquon Barkley (RB, PHI)", players[0].Player.Description())
+	assert.Empty(t, picks)
+
+	players, picks = trade.Received("u2")
+	require.Len(t, players, 1)
+	assert.Equal(t, "Justin Jefferson", players[0].Player.Name)
+	require.Len(t, picks, 1)
+	assert.Equal(t, "u3", picks[0].OriginalUserID)
+}
+
+func TestTransaction_FAABRemaining(t *testing.T) {
+	_, ok := domain.Transaction{Type: domain.TransactionTypeWaiver}.FAABRemaining()
+	assert.False(t, ok, "leagues without FAAB have nothing remaining")
+
+	remaining, ok := domain.Transaction{FAABBudget: 100, FAABUsed: 44}.FAABRemaining()
+	assert.True(t, ok)
+	assert.Equal(t, 56, remaining)
+}
+
+func TestPlayer_Description(t *testing.T) {
+	assert.Equal(t, "Ja'Marr Chase (WR, CIN)", domain.Player{Name: "Ja'Marr Chase", Position: "WR", Team: "CIN"}.Description())
+	assert.Equal(t, "Free Agent (TE)", domain.Player{Name: "Free Agent", Position: "TE"}.Description())
+	assert.Equal(t, "1234", domain.Player{Name: "1234"}.Description())
+}
+
+func TestDecodePlayers(t *testing.T) {
+	players, err := sleeper.DecodePlayers([]byte(`{
+		"4866": {"player_id": "4866", "full_name": "Saquon Barkley", "position": "RB", "team": "PHI"},
+		"DAL": {"player_id": "DAL", "first_name": "Dallas", "last_name": "Cowboys", "position": "DEF", "team": "DAL"}
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Saquon Barkley", players["4866"].Name())
+	assert.Equal(t, "Dallas Cowboys", players["DAL"].Name(), "defenses are named after their team")
+
+	_, err = sleeper.DecodePlayers([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestSyncTransactions_AttributesMovesToTheSeasonsOwners(t *testing.T) {
+	var players []db.InsertTransactionPlayerParams
+	var picks []db.InsertTransactionDraftPickParams
+	mockDB := &dependency.MockDatabase{
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{ID: "league2023", Year: year, Status: domain.LeagueStatusComplete}, nil
+		},
+		// former owned roster 1 in 2023 and has since handed it to owner1
+		GetFranchiseOwnersByYearFunc: func(ctx context.Context, year int32) ([]db.FranchiseOwner, error) {
+			return []db.FranchiseOwner{{FranchiseID: 1, Year: year, UserID: "former"}}, nil
+		},
+		InsertTransactionPlayerFunc: func(ctx context.Context, arg db.InsertTransactionPlayerParams) error {
+			players = append(players, arg)
+			return nil
+		},
+		InsertTransactionDraftPickFunc: func(ctx context.Context, arg db.InsertTransactionDraftPickParams) error {
+			picks = append(picks, arg)
+			return nil
+		},
+	}
+	sleeperClient := &dependency.MockSleeperClient{
+		GetRostersInLeagueFunc: func(ctx context.Context, leagueID string) (sleeper.Rosters, error) {
+			return sleeper.Rosters{{ID: 1, OwnerID: "owner1"}, {ID: 2, OwnerID: "owner2"}}, nil
+		},
+		GetTransactionsFunc: func(ctx context.Context, leagueID string, week int) (sleeper.Transactions, error) {
+			if week != 5 {
+				return nil, nil
+			}
+			return sleeper.Transactions{{
+				ID:         "trade1",
+				Type:       domain.TransactionTypeTrade,
+				Status:     sleeper.TransactionStatusComplete,
+				Week:       5,
+				Adds:       map[string]int{"4866": 1},
+				Drops:      map[string]int{"4866": 2},
+				DraftPicks: []sleeper.TransactionDraftPick{{Season: "2024", Round: 1, RosterID: 1, PreviousOwnerID: 1, OwnerID: 2}},
+			}}, nil
+		},
+	}
+	i := newTestInteractor(mockDB, sleeperClient)
+
+	require.NoError(t, i.SyncTransactions(context.Background(), 2023))
+
+	require.Len(t, players, 2)
+	owners := map[string]string{}
+	for _, p := range players {
+		owners[p.Action] = p.UserID
+	}
+	assert.Equal(t, map[string]string{domain.TransactionActionAdd: "former", domain.TransactionActionDrop: "owner2"}, owners)
+	require.Len(t, picks, 1)
+	assert.Equal(t, "former", picks[0].OriginalUserID)
+	assert.Equal(t, "former", picks[0].FromUserID)
+	assert.Equal(t, "owner2", picks[0].ToUserID, "rosters without a recorded owner fall back to Sleeper")
+}
+
+func TestGetUnpostedTransactions_FAABSpentAtTheTimeOfEachClaim(t *testing.T) {
+	claims := []db.Transaction{
+		{ID: "waiver1", Year: 2024, Week: 3, Type: domain.TransactionTypeWaiver, WaiverBid: 10},
+		{ID: "waiver2", Year: 2024, Week: 4, Type: domain.TransactionTypeWaiver, WaiverBid: 5},
+		{ID: "waiver3", Year: 2024, Week: 5, Type: domain.TransactionTypeWaiver, WaiverBid: 20},
+	}
+	mockDB := &dependency.MockDatabase{
+		GetUnpostedTransactionsByYearFunc: func(ctx context.Context, year int32) ([]db.Transaction, error) {
+			return []db.Transaction{claims[0], claims[2]}, nil
+		},
+		GetTransactionsByYearFunc: func(ctx context.Context, year int32) ([]db.Transaction, error) {
+			return claims, nil
+		},
+		GetTransactionPlayersByYearFunc: func(ctx context.Context, year int32) ([]db.TransactionPlayer, error) {
+			return []db.TransactionPlayer{
+				{TransactionID: "waiver1", PlayerID: "1", Action: domain.TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+				{TransactionID: "waiver2", PlayerID: "2", Action: domain.TransactionActionAdd, FranchiseID: 2, UserID: "u2"},
+				{TransactionID: "waiver3", PlayerID: "3", Action: domain.TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+			}, nil
+		},
+		GetPlayersByIDsFunc: func(ctx context.Context, ids []string) ([]db.Player, error) {
+			var players []db.Player
+			for _, id := range ids {
+				players = append(players, db.Player{ID: id, Name: "Player " + id})
+			}
+			return players, nil
+		},
+		GetLeagueByYearFunc: func(ctx context.Context, year int32) (db.League, error) {
+			return db.League{ID: "league2024", Year: year, Status: domain.LeagueStatusInProgress}, nil
+		},
+	}
+	sleeperClient := &dependency.MockSleeperClient{
+		GetLeagueFunc: func(ctx context.Context, leagueID string) (sleeper.SleeperLeague, error) {
+			return sleeper.SleeperLeague{Settings: sleeper.LeagueSettings{WaiverBudget: 100}}, nil
+		},
+	}
+	i := newTestInteractor(mockDB, sleeperClient)
+
+	transactions, err := i.GetUnpostedTransactions(context.Background(), 2024)
+	require.NoError(t, err)
+
+	require.Len(t, transactions, 2)
+	remaining, ok := transactions[0].FAABRemaining()
+	assert.True(t, ok)
+	assert.Equal(t, 90, remaining, "the first claim only spent its own bid")
+	remaining, _ = transactions[1].FAABRemaining()
+	assert.Equal(t, 70, remaining, "the later claim adds to the team's earlier bids, not other teams'")
+}
@@ -2,6 +2,7 @@ package interactor
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
 	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
@@ -9,6 +10,8 @@ import (
 
 type UsersInteractor interface {
 	GetUsers(ctx context.Context) (domain.UserMap, error)
+	GetAvatarURL(ctx context.Context, userID string) (string, error)
+	GetManagerNames(ctx context.Context) (domain.UserMap, error)
 }
 
 func (i *interactor) GetUsers(ctx context.Context) (domain.UserMap, error) {
@@ -18,3 +21,29 @@ func (i *interactor) GetUsers(ctx context.Context) (domain.UserMap, error) {
 	}
 	return converters.UsersToUserMap(users), nil
 }
+
+// GetAvatarURL retrieves the URL of a user's Sleeper avatar thumbnail.
+// Users without an avatar return an empty URL.
+func (i *interactor) GetAvatarURL(ctx context.Context, userID string) (string, error) {
+	user, err := i.SleeperClient.GetUser(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Sleeper user %s: %w", userID, err)
+	}
+	return user.AvatarURL(), nil
+}
+
+// GetManagerNames retrieves every user for display, with owners of co-owned franchises named after everyone
+// managing them (e.g. "John Doe & Jane Smith") since their results belong to the whole team
+func (i *interactor) GetManagerNames(ctx context.Context) (domain.UserMap, error) {
+	users, err := i.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	franchises, err := i.GetFranchises(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return franchises.WithManagerNames(users), nil
+}
@@ -8,30 +8,12 @@ import (
 
 	"github.com/sam-maryland/any-given-sunday/internal/dependency"
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
-	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
 	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 )
 
-// testableUsersInteractor allows us to test with mock dependencies
-type testableUsersInteractor struct {
-	chain *dependency.TestChain
-}
-
-func (i *testableUsersInteractor) GetUsers(ctx context.Context) (domain.UserMap, error) {
-	users, err := i.chain.DB.GetUsers(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return converters.UsersToUserMap(users), nil
-}
-
-func newTestableUsersInteractor(chain *dependency.TestChain) *testableUsersInteractor {
-	return &testableUsersInteractor{chain: chain}
-}
-
 func TestGetUsers(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -175,8 +157,7 @@ func TestGetUsers(t *testing.T) {
 				},
 			}
 
-			chain := dependency.NewTestChain(mockDB, nil, nil)
-			interactor := newTestableUsersInteractor(chain)
+			interactor := newTestInteractor(mockDB, nil)
 
 			result, err := interactor.GetUsers(context.Background())
 
@@ -220,8 +201,7 @@ func TestGetUsers_ContextCancellation(t *testing.T) {
 		},
 	}
 
-	chain := dependency.NewTestChain(mockDB, nil, nil)
-	interactor := newTestableUsersInteractor(chain)
+	interactor := newTestInteractor(mockDB, nil)
 
 	result, err := interactor.GetUsers(ctx)
 
@@ -261,8 +241,7 @@ func TestGetUsers_LargeDataSet(t *testing.T) {
 		},
 	}
 
-	chain := dependency.NewTestChain(mockDB, nil, nil)
-	interactor := newTestableUsersInteractor(chain)
+	interactor := newTestInteractor(mockDB, nil)
 
 	result, err := interactor.GetUsers(context.Background())
 
@@ -295,8 +274,7 @@ func TestGetUsers_DuplicateUserIDs(t *testing.T) {
 		},
 	}
 
-	chain := dependency.NewTestChain(mockDB, nil, nil)
-	interactor := newTestableUsersInteractor(chain)
+	interactor := newTestInteractor(mockDB, nil)
 
 	result, err := interactor.GetUsers(context.Background())
 
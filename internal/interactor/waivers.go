@@ -0,0 +1,74 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+type WaiverInteractor interface {
+	GetWaiverReport(ctx context.Context, year int) (domain.WaiverReport, error)
+}
+
+// GetWaiverReport summarizes a season's waiver wire activity. FAAB spent and roster moves come from Sleeper's
+// rosters, and pickups from the recorded transactions.
+func (i *interactor) GetWaiverReport(ctx context.Context, year int) (domain.WaiverReport, error) {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return domain.WaiverReport{}, fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return domain.WaiverReport{}, fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+
+	rosters, err := i.SleeperClient.GetRostersInLeague(ctx, league.ID)
+	if err != nil {
+		return domain.WaiverReport{}, fmt.Errorf("failed to get rosters from Sleeper: %w", err)
+	}
+
+	managers := make([]domain.WaiverManager, 0, len(rosters))
+	for _, r := range rosters {
+		managers = append(managers, domain.WaiverManager{
+			UserID:     r.OwnerID,
+			FAABSpent:  r.Settings.WaiverBudgetUsed,
+			FAABBudget: sleeperLeague.Settings.WaiverBudget,
+			Moves:      r.Settings.TotalMoves,
+		})
+	}
+
+	pickups, _, err := i.getPickups(ctx, year)
+	if err != nil {
+		return domain.WaiverReport{}, err
+	}
+
+	return domain.NewWaiverReport(year, managers, pickups), nil
+}
+
+// getPickups returns the season's waiver and free agent pickups along with the player scores used to value them
+func (i *interactor) getPickups(ctx context.Context, year int) ([]domain.Pickup, domain.PlayerScores, error) {
+	transactions, err := i.GetTransactions(ctx, year)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored, err := i.DB.GetPlayerScoresByYear(ctx, int32(year))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get player scores: %w", err)
+	}
+	scores := converters.PlayerScoresFromDB(stored)
+
+	return domain.NewPickups(transactions, scores), scores, nil
+}
+
+// getWaiverWireHero returns the week's waiver wire hero award, or nil if no pickup was started that week
+func (i *interactor) getWaiverWireHero(ctx context.Context, year, week int) (*domain.WeeklyAward, error) {
+	pickups, scores, err := i.getPickups(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+	return domain.WaiverWireHeroAward(pickups, scores, week), nil
+}
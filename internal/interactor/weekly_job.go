@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
 	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
 	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
 )
 
@@ -14,6 +15,7 @@ type WeeklyJobInteractor interface {
 	SyncLatestData(ctx context.Context, year int) error
 	GetWeeklyHighScore(ctx context.Context, year, week int) (*WeeklyHighScore, error)
 	GenerateWeeklySummary(ctx context.Context, year int) (*WeeklySummary, error)
+	GenerateWeeklySummaryForWeek(ctx context.Context, year, week int) (*WeeklySummary, error)
 }
 
 type WeeklyHighScore struct {
@@ -22,14 +24,16 @@ type WeeklyHighScore struct {
 	Score      float64
 	Week       int
 	Year       int
-	PaymentDue float64 // Always $15 for regular season
+	PaymentDue float64 // Weekly high score payout from the season's payout rules
 }
 
 type WeeklySummary struct {
 	LeagueID       string
 	Year           int
 	Week           int
+	LatestWeek     int // Latest completed week of the year, used to page through past summaries
 	HighScore      *WeeklyHighScore
+	Awards         domain.WeeklyAwards
 	Standings      domain.Standings
 	DataSyncStatus string
 }
@@ -48,9 +52,29 @@ func (i *interactor) SyncLatestData(ctx context.Context, year int) error {
 		return fmt.Errorf("failed to get NFL state: %w", err)
 	}
 
+	// Keep franchises, their owners and co-owners up to date (optional, matchups are recorded under roster owners either way)
+	if err := i.syncFranchises(ctx, league); err != nil {
+		fmt.Printf("Failed to sync franchises: %v\n", err)
+	}
+
+	// Record the season's draft once it has happened (optional, like franchises)
+	if err := i.SyncDraft(ctx, year); err != nil {
+		fmt.Printf("Failed to sync draft: %v\n", err)
+	}
+	if err := i.SyncTradedPicks(ctx, year); err != nil {
+		fmt.Printf("Failed to sync traded picks: %v\n", err)
+	}
+
+	// Player scores that are already recorded are only written again if they change
+	recordedScores, err := i.DB.GetPlayerScoresByYear(ctx, int32(year))
+	if err != nil {
+		return fmt.Errorf("failed to get player scores for year %d: %w", year, err)
+	}
+	recorded := newRecordedPlayerScores(recordedScores)
+
 	// Sync data for each week up to the current week
 	for week := 1; week < nflState.Week; week++ {
-		err := i.syncWeekData(ctx, league.ID, year, week)
+		err := i.syncWeekData(ctx, league.ID, year, week, recorded)
 		if err != nil {
 			// Log error but continue with other weeks
 			fmt.Printf("Failed to sync week %d: %v\n", week, err)
@@ -61,7 +85,7 @@ func (i *interactor) SyncLatestData(ctx context.Context, year int) error {
 }
 
 // syncWeekData syncs matchup data for a specific week
-func (i *interactor) syncWeekData(ctx context.Context, leagueID string, year, week int) error {
+func (i *interactor) syncWeekData(ctx context.Context, leagueID string, year, week int, recorded recordedPlayerScores) error {
 	// Fetch matchups from Sleeper API
 	sleeperMatchups, err := i.SleeperClient.GetMatchupsForWeek(ctx, leagueID, week)
 	if err != nil {
@@ -94,6 +118,60 @@ func (i *interactor) syncWeekData(ctx context.Context, leagueID string, year, we
 		}
 	}
 
+	if err := i.recordPlayerScores(ctx, sleeperMatchups, rosterToOwner, year, week, recorded); err != nil {
+		return fmt.Errorf("failed to record player scores: %w", err)
+	}
+
+	return nil
+}
+
+// recordedPlayerScores holds a season's recorded player scores by week and player
+type recordedPlayerScores map[playerScoreKey]db.PlayerScore
+
+type playerScoreKey struct {
+	week     int32
+	playerID string
+}
+
+func newRecordedPlayerScores(scores []db.PlayerScore) recordedPlayerScores {
+	recorded := make(recordedPlayerScores, len(scores))
+	for _, score := range scores {
+		recorded[playerScoreKey{score.Week, score.PlayerID}] = score
+	}
+	return recorded
+}
+
+// recordPlayerScores records what every rostered player scored for the week and whether they were started,
+// including on teams with a bye, so trades can be graded on the points players went on to score.
+// Only scores that are new or have changed since they were recorded (e.g. stat corrections) are written.
+func (i *interactor) recordPlayerScores(ctx context.Context, sleeperMatchups sleeper.Matchups, rosterToOwner map[int]string, year, week int, recorded recordedPlayerScores) error {
+	for _, sm := range sleeperMatchups {
+		starters := make(map[string]bool, len(sm.Starters))
+		for _, id := range sm.Starters {
+			starters[id] = true
+		}
+
+		for _, playerID := range sm.Players {
+			score := db.PlayerScore{
+				Year:        int32(year),
+				Week:        int32(week),
+				PlayerID:    playerID,
+				FranchiseID: int32(sm.RosterID),
+				UserID:      rosterToOwner[sm.RosterID],
+				Points:      sm.PlayersPointsMap[playerID],
+				Started:     starters[playerID],
+			}
+			key := playerScoreKey{score.Week, playerID}
+			if existing, ok := recorded[key]; ok && existing == score {
+				continue
+			}
+
+			if err := i.DB.UpsertPlayerScore(ctx, db.UpsertPlayerScoreParams(score)); err != nil {
+				return fmt.Errorf("failed to record score for player %s: %w", playerID, err)
+			}
+			recorded[key] = score
+		}
+	}
 	return nil
 }
 
@@ -199,8 +277,8 @@ func (i *interactor) upsertMatchup(ctx context.Context, matchup domain.Matchup,
 			return fmt.Errorf("failed to insert matchup: %w", err)
 		}
 	} else {
-		// Matchup exists, update scores if they've changed
-		if existing.HomeScore != matchup.HomeScore || existing.AwayScore != matchup.AwayScore {
+		// Matchup exists, update scores if they've changed, unless the commissioner has corrected them
+		if !existing.ScoresCorrected && (existing.HomeScore != matchup.HomeScore || existing.AwayScore != matchup.AwayScore) {
 			err = i.DB.UpdateMatchupScores(ctx, db.UpdateMatchupScoresParams{
 				Year:       int32(year),
 				Week:       int32(week),
@@ -237,18 +315,29 @@ func (i *interactor) GetWeeklyHighScore(ctx context.Context, year, week int) (*W
 		return nil, fmt.Errorf("failed to get user details: %w", err)
 	}
 
+	rules, err := i.GetPayoutRules(ctx, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payout rules: %w", err)
+	}
+
 	return &WeeklyHighScore{
 		UserID:     result.WinnerUserID,
 		UserName:   user.Name,
 		Score:      result.WinningScore,
 		Week:       int(result.Week),
 		Year:       int(result.Year),
-		PaymentDue: 15.00, // $15 for regular season high score
+		PaymentDue: float64(rules.WeeklyHighScore),
 	}, nil
 }
 
 // GenerateWeeklySummary creates a comprehensive weekly summary
 func (i *interactor) GenerateWeeklySummary(ctx context.Context, year int) (*WeeklySummary, error) {
+	return i.GenerateWeeklySummaryForWeek(ctx, year, 0)
+}
+
+// GenerateWeeklySummaryForWeek creates the weekly summary for a specific completed week.
+// A week of 0 means the latest completed week. Summaries of earlier weeks show the standings as of that week.
+func (i *interactor) GenerateWeeklySummaryForWeek(ctx context.Context, year, week int) (*WeeklySummary, error) {
 	// Get the latest completed week
 	latestWeek, err := i.DB.GetLatestCompletedWeek(ctx, int32(year))
 	if err != nil {
@@ -259,8 +348,15 @@ func (i *interactor) GenerateWeeklySummary(ctx context.Context, year int) (*Week
 		return nil, fmt.Errorf("no completed weeks found for year %d", year)
 	}
 
-	// Get the high score for the latest completed week
-	highScore, err := i.GetWeeklyHighScore(ctx, year, int(latestWeek))
+	if week == 0 {
+		week = int(latestWeek)
+	}
+	if week < 1 || week > int(latestWeek) {
+		return nil, fmt.Errorf("week %d of %d has not been completed", week, year)
+	}
+
+	// Get the high score for the week
+	highScore, err := i.GetWeeklyHighScore(ctx, year, week)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get weekly high score: %w", err)
 	}
@@ -271,22 +367,78 @@ func (i *interactor) GenerateWeeklySummary(ctx context.Context, year int) (*Week
 		return nil, fmt.Errorf("failed to get league: %w", err)
 	}
 
-	// Calculate current standings
-	standings, err := i.GetStandingsForLeague(ctx, league)
+	// Calculate the standings as of the week
+	var standings domain.Standings
+	if week == int(latestWeek) {
+		standings, err = i.GetStandingsForLeague(ctx, league)
+	} else {
+		standings, err = i.getStandingsThroughWeek(ctx, year, week)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get standings: %w", err)
 	}
 
+	// Calculate the rest of the week's awards
+	awards, err := i.getWeeklyAwards(ctx, year, week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get weekly awards: %w", err)
+	}
+
 	return &WeeklySummary{
 		LeagueID:       league.ID,
 		Year:           year,
-		Week:           int(latestWeek),
+		Week:           week,
+		LatestWeek:     int(latestWeek),
 		HighScore:      highScore,
+		Awards:         awards,
 		Standings:      standings,
 		DataSyncStatus: i.calculateDataSyncStatus(ctx, year, int(latestWeek)),
 	}, nil
 }
 
+// getStandingsThroughWeek calculates the regular season standings using only matchups up to and including a week
+func (i *interactor) getStandingsThroughWeek(ctx context.Context, year, week int) (domain.Standings, error) {
+	matchups, err := i.DB.GetMatchupsByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matchups for year %d: %w", year, err)
+	}
+
+	var throughWeek domain.Matchups
+	for _, m := range converters.MatchupsFromDB(matchups) {
+		if m.Week <= week {
+			throughWeek = append(throughWeek, m)
+		}
+	}
+
+	return domain.MatchupsToStandingsMap(throughWeek).SortStandingsMap(), nil
+}
+
+// getWeeklyAwards calculates the default weekly awards for a specific week, followed by the waiver wire hero
+func (i *interactor) getWeeklyAwards(ctx context.Context, year, week int) (domain.WeeklyAwards, error) {
+	matchups, err := i.DB.GetMatchupsByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matchups for year %d: %w", year, err)
+	}
+
+	var weekMatchups domain.Matchups
+	for _, m := range converters.MatchupsFromDB(matchups) {
+		if m.Week == week {
+			weekMatchups = append(weekMatchups, m)
+		}
+	}
+
+	awards := domain.CalculateWeeklyAwards(weekMatchups, domain.DefaultWeeklyAwards...)
+
+	hero, err := i.getWaiverWireHero(ctx, year, week)
+	if err != nil {
+		return nil, err
+	}
+	if hero != nil {
+		awards = append(awards, *hero)
+	}
+	return awards, nil
+}
+
 // calculateDataSyncStatus determines the current sync status between local data and Sleeper API
 func (i *interactor) calculateDataSyncStatus(ctx context.Context, year, latestWeek int) string {
 	// Get current NFL state to check the actual current week
@@ -0,0 +1,83 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/converters"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+)
+
+type YearInReviewInteractor interface {
+	GetYearInReviews(ctx context.Context, year int) (domain.YearInReviews, error)
+}
+
+// GetYearInReviews builds every manager's personalized year-in-review for a season,
+// ordered by final regular season rank.
+func (i *interactor) GetYearInReviews(ctx context.Context, year int) (domain.YearInReviews, error) {
+	league, err := i.GetLeagueByYear(ctx, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league for year %d: %w", year, err)
+	}
+
+	matchups, err := i.DB.GetMatchupsByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matchups for year %d: %w", year, err)
+	}
+
+	// Synced playoff games aren't flagged as playoffs, so use the league's playoff start week as well
+	sleeperLeague, err := i.SleeperClient.GetLeague(ctx, league.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league from Sleeper: %w", err)
+	}
+	regularSeason := regularSeasonMatchups(converters.MatchupsFromDB(matchups), sleeperLeague.Settings.PlayoffWeekStart)
+
+	benchPoints, err := i.getBenchPoints(ctx, year, regularSeason)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := i.GetLedgerBalances(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+	winnings := make(map[string]int, len(balances))
+	for _, b := range balances {
+		winnings[b.UserID] = b.Balance
+	}
+
+	var podium []string
+	if league.Status == domain.LeagueStatusComplete {
+		podium = []string{league.FirstPlace, league.SecondPlace, league.ThirdPlace}
+	}
+
+	reviews := domain.NewYearInReviews(year, regularSeason)
+	for idx := range reviews {
+		reviews[idx].BenchPoints = benchPoints[reviews[idx].UserID]
+		reviews[idx].NetWinnings = winnings[reviews[idx].UserID]
+		if place := slices.Index(podium, reviews[idx].UserID); place >= 0 {
+			reviews[idx].Placement = place + 1
+		}
+	}
+
+	return reviews, nil
+}
+
+// getBenchPoints totals the points scored by each user's bench players across the weeks of the given matchups,
+// using the player scores synced for the season.
+func (i *interactor) getBenchPoints(ctx context.Context, year int, matchups domain.Matchups) (map[string]float64, error) {
+	scores, err := i.DB.GetPlayerScoresByYear(ctx, int32(year))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player scores for year %d: %w", year, err)
+	}
+
+	var weeks []int
+	for _, m := range matchups {
+		if !slices.Contains(weeks, m.Week) {
+			weeks = append(weeks, m.Week)
+		}
+	}
+
+	return converters.PlayerScoresFromDB(scores).BenchPoints(weeks), nil
+}
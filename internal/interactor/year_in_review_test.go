@@ -0,0 +1,49 @@
+package interactor
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewYearInReviews(t *testing.T) {
+	matchups := domain.Matchups{
+		{Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 100.0, AwayScore: 120.0},
+		{Year: 2024, Week: 1, HomeUserID: "user3", AwayUserID: "user4", HomeScore: 90.0, AwayScore: 80.0},
+		{Year: 2024, Week: 2, HomeUserID: "user1", AwayUserID: "user3", HomeScore: 140.0, AwayScore: 70.0},
+		{Year: 2024, Week: 2, HomeUserID: "user2", AwayUserID: "user4", HomeScore: 110.0, AwayScore: 115.0},
+		{Year: 2024, Week: 3, HomeUserID: "user1", AwayUserID: "user4", HomeScore: 130.0, AwayScore: 60.0},
+		{Year: 2024, Week: 3, HomeUserID: "user2", AwayUserID: "user3", HomeScore: 95.0, AwayScore: 105.0},
+	}
+
+	reviews := domain.NewYearInReviews(2024, matchups)
+	require.Len(t, reviews, 4)
+
+	// user1 finishes 2-1 with the most points, ahead of user3 (2-1) on head-to-head
+	user1 := reviews[0]
+	assert.Equal(t, "user1", user1.UserID)
+	assert.Equal(t, 2, user1.Wins)
+	assert.Equal(t, 1, user1.Losses)
+	assert.Equal(t, 370.0, user1.PointsFor)
+	assert.Equal(t, 1, user1.FinalRank())
+	assert.Len(t, user1.Ranks, 3)
+	assert.Equal(t, &domain.WeekScore{Week: 2, Score: 140.0}, user1.BestWeek)
+	assert.Equal(t, &domain.WeekScore{Week: 1, Score: 100.0}, user1.WorstWeek)
+	assert.Equal(t, &domain.OpponentRecord{OpponentID: "user3", Wins: 1}, user1.MostBeaten)
+	assert.Equal(t, &domain.OpponentRecord{OpponentID: "user2", Losses: 1}, user1.Nemesis)
+
+	for _, r := range reviews {
+		assert.Len(t, r.Ranks, 3, "rank trajectory for %s", r.UserID)
+	}
+
+	message := user1.ToDiscordMessage(domain.UserMap{"user1": {ID: "user1", Name: "Alice"}, "user2": {ID: "user2", Name: "Bob"}})
+	assert.Contains(t, message, "Alice's 2024 Year in Review")
+	assert.Contains(t, message, "**Nemesis:** Bob (0-1)")
+	assert.Contains(t, message, "**Record:** 2-1-0 (#1 in the regular season)")
+
+	user1.Placement = 2
+	assert.Contains(t, user1.ToDiscordMessage(nil), "**Record:** 2-1-0 (finished #2)", "the podium placement wins over the regular season rank")
+}
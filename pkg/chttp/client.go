@@ -0,0 +1,215 @@
+package chttp
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Defaults for clients created with NewClient
+const (
+	DefaultTimeout    = 15 * time.Second
+	DefaultMaxRetries = 3
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// ClientOptions configures a client created with NewClient. Zero values use the defaults.
+type ClientOptions struct {
+	Timeout           time.Duration // How long each attempt of a request can take, including reading the body
+	MaxRetries        int           // How many times a request is retried after a 429 or 5xx response (-1 for never)
+	RequestsPerMinute int           // Client-side rate limit shared by every request (0 for no limit)
+	Transport         http.RoundTripper
+}
+
+// NewClient creates an HTTP client that times out each attempt of a request, retries 429 and 5xx responses with
+// exponential backoff and jitter, honoring Retry-After, and optionally rate limits requests.
+func NewClient(opts ClientOptions) *http.Client {
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = DefaultMaxRetries
+	}
+	if opts.Transport == nil {
+		opts.Transport = http.DefaultTransport
+	}
+
+	t := &transport{
+		base:       opts.Transport,
+		timeout:    opts.Timeout,
+		maxRetries: max(opts.MaxRetries, 0),
+		sleep:      sleep,
+	}
+	if opts.RequestsPerMinute > 0 {
+		t.limiter = newRateLimiter(opts.RequestsPerMinute, time.Minute)
+	}
+	return &http.Client{Transport: t}
+}
+
+type timeoutKey struct{}
+
+// WithTimeout overrides the per-attempt timeout of requests made with the context, for requests that are
+// expected to take longer than most (e.g. large downloads)
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, timeout)
+}
+
+type transport struct {
+	base       http.RoundTripper
+	timeout    time.Duration
+	maxRetries int
+	limiter    *rateLimiter
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.timeout
+	if override, ok := req.Context().Value(timeoutKey{}).(time.Duration); ok {
+		timeout = override
+	}
+
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := t.attempt(req, timeout)
+		if err != nil || !retryable(res.StatusCode) || attempt >= t.maxRetries || !rewindable(req) {
+			return res, err
+		}
+
+		delay := retryDelay(res, attempt)
+		_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, bodySnippetLimit))
+		res.Body.Close()
+
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends the request once, cancelling it if the response hasn't been read within the timeout
+func (t *transport) attempt(req *http.Request, timeout time.Duration) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	attempt := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		attempt.Body = body
+	}
+
+	res, err := t.base.RoundTrip(attempt)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelBody releases an attempt's timeout once its body has been closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// rewindable reports whether a request can be sent again
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// retryDelay returns how long to wait before retrying a response: its Retry-After if it has one, otherwise
+// exponential backoff with jitter
+func retryDelay(res *http.Response, attempt int) time.Duration {
+	if after, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+		return min(after, retryMaxDelay)
+	}
+
+	backoff := min(retryBaseDelay<<attempt, retryMaxDelay)
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// retryAfter parses a Retry-After header, which is either a number of seconds or an HTTP date
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket allowing a number of requests per period, refilled evenly over the period
+type rateLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	interval time.Duration // Time to refill one token
+	tokens   float64
+	last     time.Time
+}
+
+func newRateLimiter(requests int, per time.Duration) *rateLimiter {
+	return &rateLimiter{
+		capacity: float64(requests),
+		interval: per / time.Duration(requests),
+		tokens:   float64(requests),
+		last:     time.Now(),
+	}
+}
+
+// wait blocks until a request can be made or the context is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = min(l.capacity, l.tokens+float64(now.Sub(l.last))/float64(l.interval))
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) * float64(l.interval))
+		l.mu.Unlock()
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
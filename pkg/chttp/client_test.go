@@ -0,0 +1,150 @@
+package chttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testClient creates a client like NewClient whose retries record their delays instead of sleeping
+func testClient(maxRetries int, timeout time.Duration) (*http.Client, *[]time.Duration) {
+	var delays []time.Duration
+	t := &transport{
+		base:       http.DefaultTransport,
+		timeout:    timeout,
+		maxRetries: maxRetries,
+		sleep: func(ctx context.Context, d time.Duration) error {
+			delays = append(delays, d)
+			return nil
+		},
+	}
+	return &http.Client{Transport: t}, &delays
+}
+
+func TestClient_RetriesRateLimitingAndServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"week":3}`))
+		}
+	}))
+	defer server.Close()
+
+	client, delays := testClient(3, time.Second)
+	req, err := NewJSONRequest(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+
+	var state struct{ Week int }
+	require.NoError(t, JSONResponder(res, err, &state))
+	assert.Equal(t, 3, state.Week)
+	assert.EqualValues(t, 3, calls.Load())
+
+	require.Len(t, *delays, 2)
+	assert.Equal(t, 7*time.Second, (*delays)[0], "Retry-After is honored")
+	assert.GreaterOrEqual(t, (*delays)[1], retryBaseDelay)
+	assert.LessOrEqual(t, (*delays)[1], 2*retryBaseDelay)
+}
+
+func TestClient_GivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "league not found", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, _ := testClient(2, time.Second)
+	req, err := NewJSONRequest(context.Background(), http.MethodGet, server.URL+"/league/1", nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+
+	err = JSONResponder(res, err, &struct{}{})
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	assert.Equal(t, "league not found", statusErr.Body)
+	assert.Contains(t, err.Error(), "GET "+server.URL+"/league/1 returned 503 Service Unavailable")
+	assert.EqualValues(t, 3, calls.Load())
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, _ := testClient(3, time.Second)
+	req, err := NewJSONRequest(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+
+	_, err = BytesResponder(res, err)
+	assert.Equal(t, http.StatusNotFound, StatusCode(err))
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+func TestClient_TimesOutEachAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	}))
+	defer server.Close()
+
+	client, _ := testClient(0, 20*time.Millisecond)
+	req, err := NewJSONRequest(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	req, err = NewJSONRequest(WithTimeout(context.Background(), 5*time.Second), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+	require.NoError(t, err, "the timeout can be overridden per request")
+	res.Body.Close()
+}
+
+func TestRetryAfter(t *testing.T) {
+	d, ok := retryAfter("120")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, d)
+
+	d, ok = retryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Zero(t, d, "dates in the past mean retry now")
+
+	_, ok = retryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(2, 100*time.Millisecond)
+
+	start := time.Now()
+	for range 3 {
+		require.NoError(t, limiter.wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond, "the third request waits for a token")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter = newRateLimiter(1, time.Hour)
+	require.NoError(t, limiter.wait(ctx))
+	assert.ErrorIs(t, limiter.wait(ctx), context.Canceled)
+}
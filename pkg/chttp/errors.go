@@ -0,0 +1,55 @@
+package chttp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// bodySnippetLimit is how much of an error response's body is kept in a StatusError
+const bodySnippetLimit = 512
+
+// StatusError is returned for responses with a non-2xx status
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string // The start of the response body, for debugging
+}
+
+func (e *StatusError) Error() string {
+	msg := fmt.Sprintf("%s %s returned %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// NewStatusError creates a StatusError from a response, reading the start of its body.
+// The caller is still responsible for closing the body.
+func NewStatusError(res *http.Response) *StatusError {
+	e := &StatusError{StatusCode: res.StatusCode}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.URL = res.Request.URL.String()
+	}
+
+	b, _ := io.ReadAll(io.LimitReader(res.Body, bodySnippetLimit))
+	for !utf8.Valid(b) && len(b) > 0 {
+		b = b[:len(b)-1]
+	}
+	e.Body = strings.TrimSpace(string(b))
+	return e
+}
+
+// StatusCode returns the status code of a StatusError anywhere in err's chain, or 0 if there isn't one
+func StatusCode(err error) int {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	return 0
+}
@@ -40,10 +40,30 @@ func JSONResponder(res *http.Response, err error, v any) error {
 		}
 	}(res.Body)
 	if res.StatusCode >= 300 {
-		return err
+		return NewStatusError(res)
 	}
 	if v == nil {
 		return nil
 	}
 	return json.NewDecoder(res.Body).Decode(&v)
 }
+
+// BytesResponder reads and closes a response's body, returning a StatusError for non-2xx responses
+func BytesResponder(res *http.Response, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New("error in BytesResponder: incoming response was nil")
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("error closing response body: %v", err)
+		}
+	}(res.Body)
+	if res.StatusCode >= 300 {
+		return nil, NewStatusError(res)
+	}
+	return io.ReadAll(res.Body)
+}
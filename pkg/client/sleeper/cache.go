@@ -0,0 +1,268 @@
+package sleeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/chttp"
+)
+
+// How long each kind of response is cached for. Responses are still served past these while Sleeper is unavailable.
+const (
+	nflStateTTL     = 5 * time.Minute
+	userTTL         = 6 * time.Hour
+	playersTTL      = 24 * time.Hour // Sleeper asks that the player list is fetched at most once a day
+	leagueTTL       = 15 * time.Minute
+	rosterTTL       = 5 * time.Minute
+	bracketTTL      = 5 * time.Minute
+	tradedPicksTTL  = 15 * time.Minute
+	draftTTL        = 15 * time.Minute
+	currentWeekTTL  = time.Minute    // Matchups and transactions for weeks still being played
+	finishedWeekTTL = 24 * time.Hour // Matchups and transactions for weeks that are over, which stat corrections can still change
+)
+
+// leagueStatusComplete is Sleeper's status for a league whose season has finished
+const leagueStatusComplete = "complete"
+
+// CacheEntry is a cached response body
+type CacheEntry struct {
+	Body      []byte
+	FetchedAt time.Time
+	ExpiresAt time.Time // Zero if the response never needs fetching again
+}
+
+// fresh reports whether the entry can be served without asking Sleeper again
+func (e CacheEntry) fresh(now time.Time) bool {
+	return e.ExpiresAt.IsZero() || now.Before(e.ExpiresAt)
+}
+
+// CacheStore persists cached responses so they outlive the process
+type CacheStore interface {
+	// Get returns the entry cached under key, and false if there isn't one
+	Get(ctx context.Context, key string) (CacheEntry, bool, error)
+	Set(ctx context.Context, key string, entry CacheEntry) error
+}
+
+// CachingClient caches another client's responses, so repeated requests don't go to Sleeper.
+// If Sleeper fails, the last response is served regardless of its age, so the bot keeps working while Sleeper is down.
+type CachingClient struct {
+	next  ISleeperClient
+	store CacheStore // Optional
+
+	mu     sync.Mutex
+	memory map[string]CacheEntry
+
+	now func() time.Time
+}
+
+var _ ISleeperClient = (*CachingClient)(nil)
+
+// NewCachingClient caches next's responses in memory and, if store isn't nil, in store
+func NewCachingClient(next ISleeperClient, store CacheStore) *CachingClient {
+	return &CachingClient{
+		next:   next,
+		store:  store,
+		memory: make(map[string]CacheEntry),
+		now:    time.Now,
+	}
+}
+
+func (c *CachingClient) GetUser(ctx context.Context, userID string) (SleeperUser, error) {
+	return cached(ctx, c, "user/"+userID, userTTL, func() (SleeperUser, error) {
+		return c.next.GetUser(ctx, userID)
+	})
+}
+
+func (c *CachingClient) GetLeague(ctx context.Context, leagueID string) (SleeperLeague, error) {
+	return cached(ctx, c, "league/"+leagueID, leagueTTL, func() (SleeperLeague, error) {
+		return c.next.GetLeague(ctx, leagueID)
+	})
+}
+
+func (c *CachingClient) GetUsersInLeague(ctx context.Context, leagueID string) (SleeperUsers, error) {
+	return cached(ctx, c, fmt.Sprintf("league/%s/users", leagueID), userTTL, func() (SleeperUsers, error) {
+		return c.next.GetUsersInLeague(ctx, leagueID)
+	})
+}
+
+func (c *CachingClient) GetRostersInLeague(ctx context.Context, leagueID string) (Rosters, error) {
+	return cached(ctx, c, fmt.Sprintf("league/%s/rosters", leagueID), rosterTTL, func() (Rosters, error) {
+		return c.next.GetRostersInLeague(ctx, leagueID)
+	})
+}
+
+// GetMatchupsForWeek caches a finished week's matchups for a day, and for good once the league is complete
+func (c *CachingClient) GetMatchupsForWeek(ctx context.Context, leagueID string, week int) (Matchups, error) {
+	key := fmt.Sprintf("league/%s/matchups/%d", leagueID, week)
+	return cached(ctx, c, key, c.weekTTL(ctx, leagueID, week), func() (Matchups, error) {
+		return c.next.GetMatchupsForWeek(ctx, leagueID, week)
+	})
+}
+
+func (c *CachingClient) GetWinnersBracket(ctx context.Context, leagueID string) (Bracket, error) {
+	return cached(ctx, c, fmt.Sprintf("league/%s/winners_bracket", leagueID), bracketTTL, func() (Bracket, error) {
+		return c.next.GetWinnersBracket(ctx, leagueID)
+	})
+}
+
+// GetTransactions caches a finished week's transactions for a day, and for good once the league is complete
+func (c *CachingClient) GetTransactions(ctx context.Context, leagueID string, week int) (Transactions, error) {
+	key := fmt.Sprintf("league/%s/transactions/%d", leagueID, week)
+	return cached(ctx, c, key, c.weekTTL(ctx, leagueID, week), func() (Transactions, error) {
+		return c.next.GetTransactions(ctx, leagueID, week)
+	})
+}
+
+func (c *CachingClient) GetTradedPicks(ctx context.Context, leagueID string) (TradedPicks, error) {
+	return cached(ctx, c, fmt.Sprintf("league/%s/traded_picks", leagueID), tradedPicksTTL, func() (TradedPicks, error) {
+		return c.next.GetTradedPicks(ctx, leagueID)
+	})
+}
+
+func (c *CachingClient) GetDraft(ctx context.Context, draftID string) (Draft, error) {
+	return cached(ctx, c, "draft/"+draftID, draftTTL, func() (Draft, error) {
+		return c.next.GetDraft(ctx, draftID)
+	})
+}
+
+func (c *CachingClient) GetDraftPicks(ctx context.Context, draftID string) (DraftPicks, error) {
+	return cached(ctx, c, fmt.Sprintf("draft/%s/picks", draftID), draftTTL, func() (DraftPicks, error) {
+		return c.next.GetDraftPicks(ctx, draftID)
+	})
+}
+
+func (c *CachingClient) GetNFLState(ctx context.Context) (NFLState, error) {
+	return cached(ctx, c, "state/nfl", nflStateTTL, func() (NFLState, error) {
+		return c.next.GetNFLState(ctx)
+	})
+}
+
+func (c *CachingClient) FetchAllPlayers(ctx context.Context) ([]byte, error) {
+	return cached(ctx, c, "players/nfl", playersTTL, func() ([]byte, error) {
+		return c.next.FetchAllPlayers(ctx)
+	})
+}
+
+// weekTTL returns how long a week's matchups and transactions can be cached for: forever once the league is
+// complete, since nothing can change any more, for a day once the week is over, so stat corrections are picked
+// up, and otherwise briefly. Weeks are only treated as over when Sleeper says so, so errors fall back to the brief TTL.
+func (c *CachingClient) weekTTL(ctx context.Context, leagueID string, week int) time.Duration {
+	league, err := c.GetLeague(ctx, leagueID)
+	if err != nil {
+		return currentWeekTTL
+	}
+	if league.Status == leagueStatusComplete {
+		return 0
+	}
+
+	state, err := c.GetNFLState(ctx)
+	if err != nil {
+		return currentWeekTTL
+	}
+	if league.Season < state.ActiveSeason || (league.Season == state.ActiveSeason && week < state.Week) {
+		return finishedWeekTTL
+	}
+	return currentWeekTTL
+}
+
+// cached returns the response cached under key if it is fresh, otherwise fetches and caches it for ttl.
+// A ttl of 0 caches the response forever.
+func cached[T any](ctx context.Context, c *CachingClient, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	entry, ok := c.get(ctx, key)
+	if ok && entry.fresh(c.now()) {
+		if v, err := decode[T](entry.Body); err == nil {
+			return v, nil
+		}
+	}
+
+	v, err := fetch()
+	if err != nil {
+		if !ok || !serveStale(err) {
+			return v, err
+		}
+		stale, decodeErr := decode[T](entry.Body)
+		if decodeErr != nil {
+			return v, err
+		}
+		log.Printf("⚠️  Serving %s cached at %s: %v", key, entry.FetchedAt.Format(time.RFC3339), err)
+		return stale, nil
+	}
+
+	body, err := encode(v)
+	if err != nil {
+		return v, nil
+	}
+	entry = CacheEntry{Body: body, FetchedAt: c.now()}
+	if ttl > 0 {
+		entry.ExpiresAt = entry.FetchedAt.Add(ttl)
+	}
+	c.set(ctx, key, entry)
+
+	return v, nil
+}
+
+// get looks for an entry in memory, then in the store
+func (c *CachingClient) get(ctx context.Context, key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	entry, ok := c.memory[key]
+	c.mu.Unlock()
+	if ok || c.store == nil {
+		return entry, ok
+	}
+
+	entry, ok, err := c.store.Get(ctx, key)
+	if err != nil {
+		log.Printf("⚠️  Failed to read %s from the Sleeper cache: %v", key, err)
+		return CacheEntry{}, false
+	}
+	if ok {
+		c.mu.Lock()
+		c.memory[key] = entry
+		c.mu.Unlock()
+	}
+	return entry, ok
+}
+
+// set caches an entry in memory and in the store. A failure to store it only costs a later request to Sleeper.
+func (c *CachingClient) set(ctx context.Context, key string, entry CacheEntry) {
+	c.mu.Lock()
+	c.memory[key] = entry
+	c.mu.Unlock()
+
+	if c.store == nil {
+		return
+	}
+	if err := c.store.Set(ctx, key, entry); err != nil {
+		log.Printf("⚠️  Failed to write %s to the Sleeper cache: %v", key, err)
+	}
+}
+
+// serveStale reports whether a cached response should stand in for a failed request. Errors that are Sleeper's
+// answer to the request, such as a league that doesn't exist, are returned as they are.
+func serveStale(err error) bool {
+	code := chttp.StatusCode(err)
+	return code == 0 || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// encode stores byte responses as they are and everything else as JSON
+func encode(v any) ([]byte, error) {
+	if b, ok := v.([]byte); ok {
+		return b, nil
+	}
+	return json.Marshal(v)
+}
+
+func decode[T any](b []byte) (T, error) {
+	var v T
+	if p, ok := any(&v).(*[]byte); ok {
+		*p = b
+		return v, nil
+	}
+	err := json.Unmarshal(b, &v)
+	return v, err
+}
@@ -0,0 +1,53 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// cacheQuerier is the subset of the database queries the Postgres cache store needs
+type cacheQuerier interface {
+	GetSleeperCacheEntry(ctx context.Context, key string) (db.SleeperCache, error)
+	UpsertSleeperCacheEntry(ctx context.Context, arg db.UpsertSleeperCacheEntryParams) error
+}
+
+// PostgresCacheStore keeps cached Sleeper responses in the sleeper_cache table
+type PostgresCacheStore struct {
+	q cacheQuerier
+}
+
+var _ CacheStore = (*PostgresCacheStore)(nil)
+
+func NewPostgresCacheStore(q cacheQuerier) *PostgresCacheStore {
+	return &PostgresCacheStore{q: q}
+}
+
+func (s *PostgresCacheStore) Get(ctx context.Context, key string) (CacheEntry, bool, error) {
+	row, err := s.q.GetSleeperCacheEntry(ctx, key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return CacheEntry{}, false, nil
+	}
+	if err != nil {
+		return CacheEntry{}, false, err
+	}
+
+	entry := CacheEntry{Body: row.Body, FetchedAt: row.FetchedAt.Time}
+	if row.ExpiresAt.Valid {
+		entry.ExpiresAt = row.ExpiresAt.Time
+	}
+	return entry, true, nil
+}
+
+func (s *PostgresCacheStore) Set(ctx context.Context, key string, entry CacheEntry) error {
+	return s.q.UpsertSleeperCacheEntry(ctx, db.UpsertSleeperCacheEntryParams{
+		Key:       key,
+		Body:      entry.Body,
+		FetchedAt: pgtype.Timestamptz{Time: entry.FetchedAt, Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: entry.ExpiresAt, Valid: !entry.ExpiresAt.IsZero()},
+	})
+}
@@ -0,0 +1,188 @@
+package sleeper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/chttp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient serves fixed responses, counting requests and failing them all once err is set
+type countingClient struct {
+	ISleeperClient
+	state    NFLState
+	league   SleeperLeague
+	matchups Matchups
+	players  []byte
+	err      error
+	calls    map[string]int
+}
+
+func newCountingClient() *countingClient {
+	return &countingClient{
+		state:    NFLState{Week: 5, ActiveSeason: "2025"},
+		league:   SleeperLeague{LeagueID: "l1", Season: "2025", Status: "in_season"},
+		matchups: Matchups{{RosterID: 1, Points: 101.5}},
+		players:  []byte(`{"4046":{"first_name":"Patrick","last_name":"Mahomes"}}`),
+		calls:    make(map[string]int),
+	}
+}
+
+func (c *countingClient) GetNFLState(ctx context.Context) (NFLState, error) {
+	c.calls["state"]++
+	return c.state, c.err
+}
+
+func (c *countingClient) GetLeague(ctx context.Context, leagueID string) (SleeperLeague, error) {
+	c.calls["league"]++
+	return c.league, c.err
+}
+
+func (c *countingClient) GetMatchupsForWeek(ctx context.Context, leagueID string, week int) (Matchups, error) {
+	c.calls["matchups"]++
+	return c.matchups, c.err
+}
+
+func (c *countingClient) FetchAllPlayers(ctx context.Context) ([]byte, error) {
+	c.calls["players"]++
+	return c.players, c.err
+}
+
+// memoryStore is a CacheStore that outlives the clients using it, like the database does
+type memoryStore map[string]CacheEntry
+
+func (s memoryStore) Get(ctx context.Context, key string) (CacheEntry, bool, error) {
+	e, ok := s[key]
+	return e, ok, nil
+}
+
+func (s memoryStore) Set(ctx context.Context, key string, entry CacheEntry) error {
+	s[key] = entry
+	return nil
+}
+
+// newTestCachingClient creates a caching client whose clock is advanced by the returned function
+func newTestCachingClient(next ISleeperClient, store CacheStore) (*CachingClient, func(time.Duration)) {
+	c := NewCachingClient(next, store)
+	now := time.Date(2025, 10, 5, 12, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	return c, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCachingClient_ExpiresEntriesAfterTheirTTL(t *testing.T) {
+	next := newCountingClient()
+	c, advance := newTestCachingClient(next, nil)
+	ctx := context.Background()
+
+	for range 3 {
+		state, err := c.GetNFLState(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 5, state.Week)
+	}
+	assert.Equal(t, 1, next.calls["state"])
+
+	advance(nflStateTTL)
+	_, err := c.GetNFLState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.calls["state"])
+}
+
+func TestCachingClient_CachesFinishedWeeksUntilStatCorrectionsAreDone(t *testing.T) {
+	next := newCountingClient()
+	c, advance := newTestCachingClient(next, nil)
+	ctx := context.Background()
+
+	// Week 4 is over, week 5 is being played
+	for _, week := range []int{4, 5} {
+		matchups, err := c.GetMatchupsForWeek(ctx, "l1", week)
+		require.NoError(t, err)
+		assert.Equal(t, next.matchups, matchups)
+	}
+	assert.Equal(t, 2, next.calls["matchups"])
+
+	advance(time.Hour)
+	_, err := c.GetMatchupsForWeek(ctx, "l1", 4)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.calls["matchups"], "finished weeks aren't fetched again straight away")
+
+	_, err = c.GetMatchupsForWeek(ctx, "l1", 5)
+	require.NoError(t, err)
+	assert.Equal(t, 3, next.calls["matchups"], "the current week is fetched again")
+
+	advance(finishedWeekTTL)
+	_, err = c.GetMatchupsForWeek(ctx, "l1", 4)
+	require.NoError(t, err)
+	assert.Equal(t, 4, next.calls["matchups"], "finished weeks are fetched again for stat corrections")
+}
+
+func TestCachingClient_CachesCompleteLeaguesForever(t *testing.T) {
+	next := newCountingClient()
+	next.league.Status = leagueStatusComplete
+	c, advance := newTestCachingClient(next, nil)
+	ctx := context.Background()
+
+	_, err := c.GetMatchupsForWeek(ctx, "l1", 4)
+	require.NoError(t, err)
+
+	advance(365 * 24 * time.Hour)
+	_, err = c.GetMatchupsForWeek(ctx, "l1", 4)
+	require.NoError(t, err)
+	assert.Equal(t, 1, next.calls["matchups"], "weeks of complete leagues are never fetched again")
+}
+
+func TestCachingClient_ServesStaleResponsesWhileSleeperIsDown(t *testing.T) {
+	next := newCountingClient()
+	c, advance := newTestCachingClient(next, nil)
+	ctx := context.Background()
+
+	_, err := c.FetchAllPlayers(ctx)
+	require.NoError(t, err)
+
+	advance(2 * playersTTL)
+	next.err = &chttp.StatusError{StatusCode: http.StatusBadGateway}
+	players, err := c.FetchAllPlayers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, next.players, players)
+	assert.Equal(t, 2, next.calls["players"])
+
+	// Errors that are Sleeper's answer to the request aren't hidden
+	next.err = &chttp.StatusError{StatusCode: http.StatusNotFound}
+	_, err = c.FetchAllPlayers(ctx)
+	assert.Equal(t, http.StatusNotFound, chttp.StatusCode(err))
+}
+
+func TestCachingClient_ReturnsErrorsWithNothingCached(t *testing.T) {
+	next := newCountingClient()
+	next.err = errors.New("connection refused")
+	c, _ := newTestCachingClient(next, nil)
+
+	_, err := c.GetNFLState(context.Background())
+	assert.ErrorIs(t, err, next.err)
+}
+
+func TestCachingClient_SharesEntriesThroughTheStore(t *testing.T) {
+	store := memoryStore{}
+	ctx := context.Background()
+
+	first, _ := newTestCachingClient(newCountingClient(), store)
+	_, err := first.GetMatchupsForWeek(ctx, "l1", 4)
+	require.NoError(t, err)
+	require.Contains(t, store, "league/l1/matchups/4")
+	entry := store["league/l1/matchups/4"]
+	assert.Equal(t, finishedWeekTTL, entry.ExpiresAt.Sub(entry.FetchedAt))
+
+	// A restarted bot is served from the store, even with Sleeper down
+	next := newCountingClient()
+	next.err = errors.New("connection refused")
+	second, _ := newTestCachingClient(next, store)
+	matchups, err := second.GetMatchupsForWeek(ctx, "l1", 4)
+	require.NoError(t, err)
+	assert.Equal(t, newCountingClient().matchups, matchups)
+	assert.Zero(t, next.calls["matchups"])
+}
@@ -3,14 +3,20 @@ package sleeper
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
+	"time"
 
 	"github.com/sam-maryland/any-given-sunday/pkg/chttp"
 )
 
-var (
-	baseURL = "https://api.sleeper.app/v1/"
+// defaultBaseURL is Sleeper's API
+const defaultBaseURL = "https://api.sleeper.app/v1/"
+
+const (
+	// requestsPerMinute keeps the client under Sleeper's limit of 1000 requests a minute, with room to spare
+	requestsPerMinute = 900
+	// playersTimeout is how long FetchAllPlayers has to download the full player list
+	playersTimeout = 2 * time.Minute
 )
 
 type ISleeperClient interface {
@@ -21,6 +27,12 @@ type ISleeperClient interface {
 	GetRostersInLeague(ctx context.Context, leagueID string) (Rosters, error)
 
 	GetMatchupsForWeek(ctx context.Context, leagueID string, week int) (Matchups, error)
+	GetWinnersBracket(ctx context.Context, leagueID string) (Bracket, error)
+	GetTransactions(ctx context.Context, leagueID string, week int) (Transactions, error)
+	GetTradedPicks(ctx context.Context, leagueID string) (TradedPicks, error)
+
+	GetDraft(ctx context.Context, draftID string) (Draft, error)
+	GetDraftPicks(ctx context.Context, draftID string) (DraftPicks, error)
 
 	GetNFLState(ctx context.Context) (NFLState, error)
 	FetchAllPlayers(ctx context.Context) ([]byte, error)
@@ -28,14 +40,26 @@ type ISleeperClient interface {
 
 type SleeperClient struct {
 	httpClient *http.Client
+	baseURL    string
 }
 
 func NewSleeperClient(c *http.Client) *SleeperClient {
-	return &SleeperClient{httpClient: c}
+	return NewSleeperClientWithBaseURL(c, defaultBaseURL)
+}
+
+// NewSleeperClientWithBaseURL creates a client for an API served somewhere other than Sleeper, such as a fake in tests
+func NewSleeperClientWithBaseURL(c *http.Client, baseURL string) *SleeperClient {
+	return &SleeperClient{httpClient: c, baseURL: baseURL}
+}
+
+// NewHTTPClient creates an HTTP client suited to Sleeper's API: requests time out, are retried when Sleeper is
+// rate limiting or unavailable, and are rate limited to stay within Sleeper's request budget
+func NewHTTPClient() *http.Client {
+	return chttp.NewClient(chttp.ClientOptions{RequestsPerMinute: requestsPerMinute})
 }
 
 func (c *SleeperClient) GetUser(ctx context.Context, userID string) (SleeperUser, error) {
-	u := fmt.Sprintf("%s/user/%s", baseURL, userID)
+	u := fmt.Sprintf("%s/user/%s", c.baseURL, userID)
 
 	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -53,7 +77,7 @@ func (c *SleeperClient) GetUser(ctx context.Context, userID string) (SleeperUser
 }
 
 func (c *SleeperClient) GetLeague(ctx context.Context, leagueID string) (SleeperLeague, error) {
-	u := fmt.Sprintf("%s/league/%s", baseURL, leagueID)
+	u := fmt.Sprintf("%s/league/%s", c.baseURL, leagueID)
 
 	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -71,7 +95,7 @@ func (c *SleeperClient) GetLeague(ctx context.Context, leagueID string) (Sleeper
 }
 
 func (c *SleeperClient) GetUsersInLeague(ctx context.Context, leagueID string) (SleeperUsers, error) {
-	u := fmt.Sprintf("%s/league/%s/users", baseURL, leagueID)
+	u := fmt.Sprintf("%s/league/%s/users", c.baseURL, leagueID)
 
 	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -89,7 +113,7 @@ func (c *SleeperClient) GetUsersInLeague(ctx context.Context, leagueID string) (
 }
 
 func (c *SleeperClient) GetRostersInLeague(ctx context.Context, leagueID string) (Rosters, error) {
-	u := fmt.Sprintf("%s/league/%s/rosters", baseURL, leagueID)
+	u := fmt.Sprintf("%s/league/%s/rosters", c.baseURL, leagueID)
 
 	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -107,7 +131,7 @@ func (c *SleeperClient) GetRostersInLeague(ctx context.Context, leagueID string)
 }
 
 func (c *SleeperClient) GetMatchupsForWeek(ctx context.Context, leagueID string, week int) (Matchups, error) {
-	u := fmt.Sprintf("%s/league/%s/matchups/%d", baseURL, leagueID, week)
+	u := fmt.Sprintf("%s/league/%s/matchups/%d", c.baseURL, leagueID, week)
 
 	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -124,26 +148,27 @@ func (c *SleeperClient) GetMatchupsForWeek(ctx context.Context, leagueID string,
 	return matchups, nil
 }
 
-func (c *SleeperClient) GetNFLState(ctx context.Context) (NFLState, error) {
-	u := fmt.Sprintf("%s/state/nfl", baseURL)
+func (c *SleeperClient) GetWinnersBracket(ctx context.Context, leagueID string) (Bracket, error) {
+	u := fmt.Sprintf("%s/league/%s/winners_bracket", c.baseURL, leagueID)
 
 	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return NFLState{}, err
+		return nil, err
 	}
 
 	res, err := c.httpClient.Do(req)
 
-	state := &NFLState{}
-	if err := chttp.JSONResponder(res, err, state); err != nil {
-		return NFLState{}, err
+	bracket := Bracket{}
+	if err := chttp.JSONResponder(res, err, &bracket); err != nil {
+		return nil, err
 	}
 
-	return *state, nil
+	return bracket, nil
 }
 
-func (c *SleeperClient) FetchAllPlayers(ctx context.Context) ([]byte, error) {
-	u := fmt.Sprintf("%s/players/nfl", baseURL)
+// GetTransactions retrieves the trades, waiver claims and free agent moves made in a week of a league
+func (c *SleeperClient) GetTransactions(ctx context.Context, leagueID string, week int) (Transactions, error) {
+	u := fmt.Sprintf("%s/league/%s/transactions/%d", c.baseURL, leagueID, week)
 
 	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -151,14 +176,101 @@ func (c *SleeperClient) FetchAllPlayers(ctx context.Context) ([]byte, error) {
 	}
 
 	res, err := c.httpClient.Do(req)
+
+	transactions := Transactions{}
+	if err := chttp.JSONResponder(res, err, &transactions); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// GetTradedPicks retrieves every draft pick in a league that is owned by a team other than the one it belongs to
+func (c *SleeperClient) GetTradedPicks(ctx context.Context, leagueID string) (TradedPicks, error) {
+	u := fmt.Sprintf("%s/league/%s/traded_picks", c.baseURL, leagueID)
+
+	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := io.ReadAll(res.Body)
+	res, err := c.httpClient.Do(req)
+
+	picks := TradedPicks{}
+	if err := chttp.JSONResponder(res, err, &picks); err != nil {
+		return nil, err
+	}
+
+	return picks, nil
+}
+
+// GetDraft retrieves a draft, such as the one a league's DraftID refers to
+func (c *SleeperClient) GetDraft(ctx context.Context, draftID string) (Draft, error) {
+	u := fmt.Sprintf("%s/draft/%s", c.baseURL, draftID)
+
+	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
+		return Draft{}, err
+	}
+
+	res, err := c.httpClient.Do(req)
+
+	draft := &Draft{}
+	if err := chttp.JSONResponder(res, err, draft); err != nil {
+		return Draft{}, err
+	}
+
+	return *draft, nil
+}
+
+// GetDraftPicks retrieves every pick made in a draft, in pick order
+func (c *SleeperClient) GetDraftPicks(ctx context.Context, draftID string) (DraftPicks, error) {
+	u := fmt.Sprintf("%s/draft/%s/picks", c.baseURL, draftID)
+
+	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.httpClient.Do(req)
+
+	picks := DraftPicks{}
+	if err := chttp.JSONResponder(res, err, &picks); err != nil {
 		return nil, err
 	}
 
-	return b, nil
+	return picks, nil
+}
+
+func (c *SleeperClient) GetNFLState(ctx context.Context) (NFLState, error) {
+	u := fmt.Sprintf("%s/state/nfl", c.baseURL)
+
+	req, err := chttp.NewJSONRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return NFLState{}, err
+	}
+
+	res, err := c.httpClient.Do(req)
+
+	state := &NFLState{}
+	if err := chttp.JSONResponder(res, err, state); err != nil {
+		return NFLState{}, err
+	}
+
+	return *state, nil
+}
+
+// FetchAllPlayers downloads every NFL player. The response is several megabytes, so it is given longer to download
+// than other requests, and Sleeper asks that it is fetched at most once a day.
+func (c *SleeperClient) FetchAllPlayers(ctx context.Context) ([]byte, error) {
+	u := fmt.Sprintf("%s/players/nfl", c.baseURL)
+
+	req, err := chttp.NewJSONRequest(chttp.WithTimeout(ctx, playersTimeout), http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.httpClient.Do(req)
+
+	return chttp.BytesResponder(res, err)
 }
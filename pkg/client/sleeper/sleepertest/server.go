@@ -0,0 +1,73 @@
+// Package sleepertest provides a fake Sleeper API that serves fixture responses, for running tests offline.
+package sleepertest
+
+import (
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/client/sleeper"
+)
+
+// Server is a fake Sleeper API. Each request is answered with the fixture at the request's path with a .json
+// extension, so GET /league/123/matchups/3 is answered with league/123/matchups/3.json. Requests for paths without
+// a fixture get a 404, so tests fail loudly rather than running on data they don't provide.
+//
+// Fixtures are hand-written JSON shaped like Sleeper's responses, not copies of a real league. Only the fields
+// the client decodes need to be present.
+type Server struct {
+	*httptest.Server
+	fixtures fs.FS
+
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+// NewServer starts a fake Sleeper API serving fixtures, which is closed when the test finishes
+func NewServer(t testing.TB, fixtures fs.FS) *Server {
+	t.Helper()
+
+	s := &Server{fixtures: fixtures, requests: make(map[string]int)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Client creates a Sleeper client that talks to the fake
+func (s *Server) Client() *sleeper.SleeperClient {
+	return sleeper.NewSleeperClientWithBaseURL(s.Server.Client(), s.URL+"/")
+}
+
+// Requests returns how many times a path has been requested, such as "league/123/matchups/3"
+func (s *Server) Requests(p string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[p]
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	// The client joins paths onto its base URL with an extra slash
+	p := strings.TrimPrefix(path.Clean(r.URL.Path), "/")
+
+	s.mu.Lock()
+	s.requests[p]++
+	s.mu.Unlock()
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	b, err := fs.ReadFile(s.fixtures, p+".json")
+	if err != nil {
+		http.Error(w, "no fixture for "+p, http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
@@ -1,8 +1,10 @@
 package sleeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 )
 
 // SleeperUser represents a user from the Sleeper API
@@ -33,6 +35,14 @@ func (u SleeperUser) String() {
 	fmt.Printf("%s - %s (%s)\n", u.TeamName(), u.DisplayName, u.ID)
 }
 
+// AvatarURL returns the URL of the user's avatar thumbnail, or an empty string if they don't have one.
+func (u SleeperUser) AvatarURL() string {
+	if u.Avatar == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://sleepercdn.com/avatars/thumbs/%s", u.Avatar)
+}
+
 func (u SleeperUser) TeamName() string {
 	if u.Metadata.TeamName == "" {
 		return u.DisplayName
@@ -106,6 +116,26 @@ func (p Player) String() {
 
 type Players []Player
 
+// Name returns the player's full name. Team defenses don't have one, so they are named after the team.
+func (p Player) Name() string {
+	if p.FullName != "" {
+		return p.FullName
+	}
+	return strings.TrimSpace(p.FirstName + " " + p.LastName)
+}
+
+// PlayerMap is every NFL player by Sleeper player ID, as returned by FetchAllPlayers
+type PlayerMap map[string]Player
+
+// DecodePlayers decodes the response of FetchAllPlayers
+func DecodePlayers(b []byte) (PlayerMap, error) {
+	players := PlayerMap{}
+	if err := json.Unmarshal(b, &players); err != nil {
+		return nil, fmt.Errorf("failed to decode players: %w", err)
+	}
+	return players, nil
+}
+
 // Roster represents a fantasy roster from Sleeper API
 type Roster struct {
 	ID       int            `json:"roster_id"`
@@ -182,6 +212,29 @@ type Matchup struct {
 
 type Matchups []Matchup
 
+// BracketMatchup represents a single game in a playoff bracket from Sleeper API
+type BracketMatchup struct {
+	Round     int  `json:"r"`
+	MatchID   int  `json:"m"`
+	Team1     int  `json:"t1"`
+	Team2     int  `json:"t2"`
+	Winner    int  `json:"w"`
+	Loser     int  `json:"l"`
+	Placement *int `json:"p,omitempty"` // Final placement decided by this game (1 = championship, 3 = third place game)
+}
+
+type Bracket []BracketMatchup
+
+// WithPlacement returns the game deciding the given final placement, if there is one.
+func (b Bracket) WithPlacement(placement int) (BracketMatchup, bool) {
+	for _, m := range b {
+		if m.Placement != nil && *m.Placement == placement {
+			return m, true
+		}
+	}
+	return BracketMatchup{}, false
+}
+
 // SleeperLeague represents the complete league data from Sleeper API
 type SleeperLeague struct {
 	TotalRosters     int             `json:"total_rosters"`
@@ -240,3 +293,99 @@ type ScoringSettings struct {
 	FumLost float64 `json:"fum_lost"`
 	// Add other scoring settings as needed
 }
+
+// Transaction types and statuses from Sleeper API
+const (
+	TransactionTypeTrade     = "trade"
+	TransactionTypeWaiver    = "waiver"
+	TransactionTypeFreeAgent = "free_agent"
+
+	TransactionStatusComplete = "complete"
+)
+
+// Transaction represents a trade, waiver claim or free agent move from Sleeper API
+type Transaction struct {
+	ID            string                 `json:"transaction_id"`
+	Type          string                 `json:"type"`
+	Status        string                 `json:"status"`
+	Week          int                    `json:"leg"`
+	Creator       string                 `json:"creator"`
+	Created       int64                  `json:"created"` // Unix milliseconds
+	StatusUpdated int64                  `json:"status_updated"`
+	RosterIDs     []int                  `json:"roster_ids"`
+	Adds          map[string]int         `json:"adds"`  // Player ID -> roster ID the player joined
+	Drops         map[string]int         `json:"drops"` // Player ID -> roster ID the player left
+	DraftPicks    []TransactionDraftPick `json:"draft_picks"`
+	Settings      TransactionSettings    `json:"settings"`
+}
+
+type TransactionSettings struct {
+	WaiverBid int `json:"waiver_bid"`
+}
+
+// TransactionDraftPick is a draft pick that changed hands in a trade
+type TransactionDraftPick struct {
+	Season          string `json:"season"`
+	Round           int    `json:"round"`
+	RosterID        int    `json:"roster_id"`         // Roster the pick originally belonged to
+	PreviousOwnerID int    `json:"previous_owner_id"` // Roster that traded the pick away
+	OwnerID         int    `json:"owner_id"`          // Roster that received the pick
+}
+
+type Transactions []Transaction
+
+// Draft represents a league's draft from Sleeper API
+type Draft struct {
+	ID             string         `json:"draft_id"`
+	LeagueID       string         `json:"league_id"`
+	Season         string         `json:"season"`
+	Type           string         `json:"type"` // snake, linear or auction
+	Status         string         `json:"status"`
+	StartTime      int64          `json:"start_time"` // Unix milliseconds
+	Settings       DraftSettings  `json:"settings"`
+	DraftOrder     map[string]int `json:"draft_order"`       // User ID -> draft slot
+	SlotToRosterID map[string]int `json:"slot_to_roster_id"` // Draft slot -> roster ID
+}
+
+type DraftSettings struct {
+	Rounds int `json:"rounds"`
+	Teams  int `json:"teams"`
+}
+
+// DraftPick represents a player picked in a draft from Sleeper API
+type DraftPick struct {
+	PlayerID  string            `json:"player_id"`
+	PickedBy  string            `json:"picked_by"` // User ID who made the pick
+	RosterID  int               `json:"roster_id"`
+	Round     int               `json:"round"`
+	DraftSlot int               `json:"draft_slot"`
+	PickNo    int               `json:"pick_no"` // Overall pick number, starting at 1
+	IsKeeper  bool              `json:"is_keeper"`
+	Metadata  DraftPickMetadata `json:"metadata"`
+}
+
+// DraftPickMetadata describes the player picked, as they were at the time of the draft
+type DraftPickMetadata struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Position  string `json:"position"`
+	Team      string `json:"team"`
+}
+
+// Name returns the picked player's full name. Team defenses are named after the team.
+func (m DraftPickMetadata) Name() string {
+	return strings.TrimSpace(m.FirstName + " " + m.LastName)
+}
+
+type DraftPicks []DraftPick
+
+// TradedPick is a future or past draft pick that has changed hands in a league from Sleeper API
+type TradedPick struct {
+	Season          string `json:"season"`
+	Round           int    `json:"round"`
+	RosterID        int    `json:"roster_id"`         // Roster the pick originally belonged to
+	PreviousOwnerID int    `json:"previous_owner_id"` // Roster that last traded the pick away
+	OwnerID         int    `json:"owner_id"`          // Roster that owns the pick now
+}
+
+type TradedPicks []TradedPick
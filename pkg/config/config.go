@@ -17,6 +17,12 @@ type Discord struct {
 	AppID            string
 	GuildID          string
 	WelcomeChannelID string
+
+	// Optional
+	CommissionerRoleID   string // Role required for /commish commands, in addition to the Manage Server permission
+	WeeklyRecapChannelID string // Channel /commish repost-recap posts to (defaults to the channel the command is used in)
+	ManagerRoleID        string // Role given to members once they link their Sleeper account
+	SetNicknames         bool   // Whether to set members' nicknames to their Sleeper team name once they link their account
 }
 
 func InitConfig() *Config {
@@ -50,9 +56,13 @@ func initDiscordConfig() Discord {
 	}
 
 	return Discord{
-		Token:            dt,
-		AppID:            aid,
-		GuildID:          gid,
-		WelcomeChannelID: wid,
+		Token:                dt,
+		AppID:                aid,
+		GuildID:              gid,
+		WelcomeChannelID:     wid,
+		CommissionerRoleID:   os.Getenv("DISCORD_COMMISSIONER_ROLE_ID"),
+		WeeklyRecapChannelID: os.Getenv("DISCORD_WEEKLY_RECAP_CHANNEL_ID"),
+		ManagerRoleID:        os.Getenv("DISCORD_MANAGER_ROLE_ID"),
+		SetNicknames:         os.Getenv("DISCORD_SET_NICKNAMES") == "true",
 	}
 }
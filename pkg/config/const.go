@@ -1,5 +1,6 @@
 package config
 
+// Default buy-in and payouts, used for seasons that have no recorded payout rules
 var (
 	PayInBuyIn            = 100
 	PayOutWeeklyHighScore = 15
@@ -7,3 +8,9 @@ var (
 	PayOutSecondPlace     = 300
 	PayOutThirdPlace      = 120
 )
+
+// Default keeper rules. Sleeper only records how many players each team can keep, so the rest are the league's own.
+var (
+	KeeperMaxYears     = 2 // Seasons in a row a player can be kept (0 for no limit)
+	KeeperRoundPenalty = 1 // Rounds earlier a kept player costs than the round they were last drafted or kept in
+)
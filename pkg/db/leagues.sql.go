@@ -9,6 +9,32 @@ import (
 	"context"
 )
 
+const completeLeague = `-- name: CompleteLeague :exec
+UPDATE leagues
+SET status = 'COMPLETE',
+    first_place = $2,
+    second_place = $3,
+    third_place = $4
+WHERE id = $1
+`
+
+type CompleteLeagueParams struct {
+	ID          string
+	FirstPlace  string
+	SecondPlace string
+	ThirdPlace  string
+}
+
+func (q *Queries) CompleteLeague(ctx context.Context, arg CompleteLeagueParams) error {
+	_, err := q.db.Exec(ctx, completeLeague,
+		arg.ID,
+		arg.FirstPlace,
+		arg.SecondPlace,
+		arg.ThirdPlace,
+	)
+	return err
+}
+
 const getLatestLeague = `-- name: GetLatestLeague :one
 SELECT id, year, first_place, second_place, third_place, status FROM (
     (
@@ -61,3 +87,43 @@ func (q *Queries) GetLeagueByYear(ctx context.Context, year int32) (League, erro
 	)
 	return i, err
 }
+
+const getLeagueYears = `-- name: GetLeagueYears :many
+SELECT year FROM leagues
+WHERE status != 'PENDING'
+ORDER BY year ASC
+`
+
+func (q *Queries) GetLeagueYears(ctx context.Context) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getLeagueYears)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var year int32
+		if err := rows.Scan(&year); err != nil {
+			return nil, err
+		}
+		items = append(items, year)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateLeagueStatus = `-- name: UpdateLeagueStatus :exec
+UPDATE leagues SET status = $2 WHERE year = $1
+`
+
+type UpdateLeagueStatusParams struct {
+	Year   int32
+	Status string
+}
+
+func (q *Queries) UpdateLeagueStatus(ctx context.Context, arg UpdateLeagueStatusParams) error {
+	_, err := q.db.Exec(ctx, updateLeagueStatus, arg.Year, arg.Status)
+	return err
+}
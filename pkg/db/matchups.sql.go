@@ -11,6 +11,34 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const correctMatchupScores = `-- name: CorrectMatchupScores :exec
+UPDATE matchups
+SET home_score = $3, away_score = $4, scores_corrected = TRUE
+WHERE year = $1 AND week = $2 AND home_user_id = $5 AND away_user_id = $6
+`
+
+type CorrectMatchupScoresParams struct {
+	Year       int32
+	Week       int32
+	HomeScore  float64
+	AwayScore  float64
+	HomeUserID string
+	AwayUserID string
+}
+
+// Corrected scores are kept by later syncs, even if Sleeper disagrees
+func (q *Queries) CorrectMatchupScores(ctx context.Context, arg CorrectMatchupScoresParams) error {
+	_, err := q.db.Exec(ctx, correctMatchupScores,
+		arg.Year,
+		arg.Week,
+		arg.HomeScore,
+		arg.AwayScore,
+		arg.HomeUserID,
+		arg.AwayUserID,
+	)
+	return err
+}
+
 const getLatestCompletedWeek = `-- name: GetLatestCompletedWeek :one
 SELECT COALESCE(MAX(week), 0)::INTEGER as latest_week
 FROM matchups
@@ -25,7 +53,7 @@ func (q *Queries) GetLatestCompletedWeek(ctx context.Context, year int32) (int32
 }
 
 const getMatchupByYearWeekUsers = `-- name: GetMatchupByYearWeekUsers :one
-SELECT id, year, week, is_playoff, playoff_round, home_user_id, away_user_id, home_seed, away_seed, home_score, away_score FROM matchups 
+SELECT id, year, week, is_playoff, playoff_round, home_user_id, away_user_id, home_seed, away_seed, home_score, away_score, scores_corrected FROM matchups 
 WHERE year = $1 AND week = $2 AND home_user_id = $3 AND away_user_id = $4
 `
 
@@ -56,6 +84,7 @@ func (q *Queries) GetMatchupByYearWeekUsers(ctx context.Context, arg GetMatchupB
 		&i.AwaySeed,
 		&i.HomeScore,
 		&i.AwayScore,
+		&i.ScoresCorrected,
 	)
 	return i, err
 }
@@ -72,7 +101,8 @@ SELECT
     home_seed,
     away_seed,
     home_score,
-    away_score
+    away_score,
+    scores_corrected
 FROM matchups
 WHERE year = $1
 ORDER BY week ASC, id ASC
@@ -99,6 +129,7 @@ func (q *Queries) GetMatchupsByYear(ctx context.Context, year int32) ([]Matchup,
 			&i.AwaySeed,
 			&i.HomeScore,
 			&i.AwayScore,
+			&i.ScoresCorrected,
 		); err != nil {
 			return nil, err
 		}
@@ -8,6 +8,14 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AdminAuditLog struct {
+	ID             pgtype.UUID
+	ActorDiscordID string
+	Action         string
+	Details        string
+	CreatedAt      pgtype.Timestamptz
+}
+
 type CareerStat struct {
 	UserID                     string
 	UserName                   string
@@ -34,6 +42,51 @@ type CareerStat struct {
 	PlayoffAvgPoints           interface{}
 }
 
+type DraftPick struct {
+	Year        int32
+	PickNo      int32
+	Round       int32
+	DraftSlot   int32
+	PlayerID    string
+	FranchiseID int32
+	UserID      string
+	IsKeeper    bool
+}
+
+type DuesPayment struct {
+	ID         pgtype.UUID
+	Year       int32
+	UserID     string
+	Amount     int32
+	RecordedBy string
+	PaidAt     pgtype.Timestamptz
+}
+
+type Franchise struct {
+	ID        int32
+	Name      string
+	OwnerID   string
+	UpdatedAt pgtype.Timestamptz
+}
+
+type FranchiseCoOwner struct {
+	FranchiseID int32
+	Year        int32
+	UserID      string
+}
+
+type FranchiseManager struct {
+	FranchiseID int32
+	UserID      string
+}
+
+type FranchiseOwner struct {
+	FranchiseID int32
+	Year        int32
+	UserID      string
+	TeamName    string
+}
+
 type League struct {
 	ID          string
 	Year        int32
@@ -43,18 +96,101 @@ type League struct {
 	Status      string
 }
 
+type LedgerEntry struct {
+	ID        pgtype.UUID
+	Year      int32
+	UserID    string
+	EntryType string
+	Week      int32
+	Amount    int32
+	Note      string
+	CreatedAt pgtype.Timestamptz
+}
+
 type Matchup struct {
-	ID           pgtype.UUID
-	Year         int32
-	Week         int32
-	IsPlayoff    pgtype.Bool
-	PlayoffRound pgtype.Text
-	HomeUserID   string
-	AwayUserID   string
-	HomeSeed     pgtype.Int4
-	AwaySeed     pgtype.Int4
-	HomeScore    float64
-	AwayScore    float64
+	ID              pgtype.UUID
+	Year            int32
+	Week            int32
+	IsPlayoff       pgtype.Bool
+	PlayoffRound    pgtype.Text
+	HomeUserID      string
+	AwayUserID      string
+	HomeSeed        pgtype.Int4
+	AwaySeed        pgtype.Int4
+	HomeScore       float64
+	AwayScore       float64
+	ScoresCorrected bool
+}
+
+type Player struct {
+	ID        string
+	Name      string
+	Position  string
+	Team      string
+	UpdatedAt pgtype.Timestamptz
+}
+
+type PlayerScore struct {
+	Year        int32
+	Week        int32
+	PlayerID    string
+	FranchiseID int32
+	UserID      string
+	Points      float64
+	Started     bool
+}
+
+type SeasonPayoutRule struct {
+	Year            int32
+	BuyIn           int32
+	WeeklyHighScore int32
+	FirstPlace      int32
+	SecondPlace     int32
+	ThirdPlace      int32
+	CreatedAt       pgtype.Timestamptz
+}
+
+type SleeperCache struct {
+	Key       string
+	Body      []byte
+	FetchedAt pgtype.Timestamptz
+	ExpiresAt pgtype.Timestamptz
+}
+
+type TradedDraftPick struct {
+	Season              int32
+	Round               int32
+	OriginalFranchiseID int32
+	OwnerFranchiseID    int32
+	PreviousFranchiseID int32
+}
+
+type Transaction struct {
+	ID        string
+	Year      int32
+	Week      int32
+	Type      string
+	WaiverBid int32
+	CreatedAt pgtype.Timestamptz
+	PostedAt  pgtype.Timestamptz
+}
+
+type TransactionDraftPick struct {
+	TransactionID       string
+	Season              int32
+	Round               int32
+	OriginalFranchiseID int32
+	OriginalUserID      string
+	FromUserID          string
+	ToUserID            string
+}
+
+type TransactionPlayer struct {
+	TransactionID string
+	PlayerID      string
+	Action        string
+	FranchiseID   int32
+	UserID        string
 }
 
 type User struct {
@@ -65,3 +201,12 @@ type User struct {
 	Email              string
 	CreatedAt          pgtype.Timestamptz
 }
+
+type WelcomeMessage struct {
+	DiscordID      string
+	ChannelID      string
+	MessageID      string
+	ReminderCount  int32
+	LastRemindedAt pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
@@ -24,6 +24,30 @@ func (q *Queries) CheckSleeperUserClaimed(ctx context.Context, id string) (bool,
 	return is_claimed, err
 }
 
+const clearUserDiscordID = `-- name: ClearUserDiscordID :exec
+UPDATE users
+SET discord_id = '', onboarding_complete = false
+WHERE id = $1
+`
+
+// Unlink a Sleeper user account from its Discord user
+func (q *Queries) ClearUserDiscordID(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, clearUserDiscordID, id)
+	return err
+}
+
+const completeUserOnboarding = `-- name: CompleteUserOnboarding :exec
+UPDATE users
+SET onboarding_complete = true
+WHERE discord_id = $1 AND discord_id != ''
+`
+
+// Mark the Sleeper user linked to a Discord user as onboarded
+func (q *Queries) CompleteUserOnboarding(ctx context.Context, discordID string) error {
+	_, err := q.db.Exec(ctx, completeUserOnboarding, discordID)
+	return err
+}
+
 const getUserByDiscordID = `-- name: GetUserByDiscordID :one
 SELECT id, name, discord_id, onboarding_complete, email, created_at FROM users WHERE discord_id = $1 LIMIT 1
 `
@@ -92,9 +116,26 @@ func (q *Queries) IsUserOnboarded(ctx context.Context, discordID string) (bool,
 	return is_onboarded, err
 }
 
+const setUserDiscordID = `-- name: SetUserDiscordID :exec
+UPDATE users
+SET discord_id = $2, onboarding_complete = false
+WHERE id = $1
+`
+
+type SetUserDiscordIDParams struct {
+	ID        string
+	DiscordID string
+}
+
+// Link a Discord user ID to a Sleeper user account, replacing any existing link
+func (q *Queries) SetUserDiscordID(ctx context.Context, arg SetUserDiscordIDParams) error {
+	_, err := q.db.Exec(ctx, setUserDiscordID, arg.ID, arg.DiscordID)
+	return err
+}
+
 const updateUserDiscordID = `-- name: UpdateUserDiscordID :exec
 UPDATE users 
-SET discord_id = $2, onboarding_complete = true 
+SET discord_id = $2, onboarding_complete = false 
 WHERE id = $1 AND (discord_id = '' OR discord_id IS NULL)
 `
 
@@ -103,7 +144,7 @@ type UpdateUserDiscordIDParams struct {
 	DiscordID string
 }
 
-// Link a Discord user ID to a Sleeper user account
+// Link a Discord user ID to a Sleeper user account. Onboarding is completed separately, once the member is set up in Discord
 func (q *Queries) UpdateUserDiscordID(ctx context.Context, arg UpdateUserDiscordIDParams) error {
 	_, err := q.db.Exec(ctx, updateUserDiscordID, arg.ID, arg.DiscordID)
 	return err
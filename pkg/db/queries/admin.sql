@@ -0,0 +1,9 @@
+-- name: InsertAdminAuditLog :exec
+INSERT INTO admin_audit_log (actor_discord_id, action, details)
+VALUES ($1, $2, $3);
+
+-- name: GetAdminAuditLog :many
+-- The most recent admin actions, newest first
+SELECT * FROM admin_audit_log
+ORDER BY created_at DESC
+LIMIT $1;
@@ -0,0 +1,16 @@
+-- name: UpsertDraftPick :exec
+-- Record a draft pick, updating it if the draft is synced again
+INSERT INTO draft_picks (year, pick_no, round, draft_slot, player_id, franchise_id, user_id, is_keeper)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (year, pick_no) DO UPDATE
+SET round = EXCLUDED.round,
+    draft_slot = EXCLUDED.draft_slot,
+    player_id = EXCLUDED.player_id,
+    franchise_id = EXCLUDED.franchise_id,
+    user_id = EXCLUDED.user_id,
+    is_keeper = EXCLUDED.is_keeper;
+
+-- name: GetDraftPicksByYear :many
+SELECT * FROM draft_picks
+WHERE year = $1
+ORDER BY pick_no;
@@ -0,0 +1,25 @@
+-- name: RecordDuesPayment :exec
+INSERT INTO dues_payments (year, user_id, amount, recorded_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (year, user_id) DO UPDATE
+SET amount = EXCLUDED.amount,
+    recorded_by = EXCLUDED.recorded_by,
+    paid_at = NOW();
+
+-- name: DeleteDuesPayment :exec
+DELETE FROM dues_payments WHERE year = $1 AND user_id = $2;
+
+-- name: GetDuesStatusByYear :many
+-- Every member with a buy-in for the season, along with how much they have paid
+SELECT
+    l.user_id,
+    u.name AS user_name,
+    u.discord_id,
+    u.email,
+    (-l.amount)::INTEGER AS amount_due,
+    COALESCE(p.amount, 0)::INTEGER AS amount_paid
+FROM ledger_entries l
+JOIN users u ON u.id = l.user_id
+LEFT JOIN dues_payments p ON p.year = l.year AND p.user_id = l.user_id
+WHERE l.year = $1 AND l.entry_type = 'BUY_IN'
+ORDER BY u.name;
@@ -0,0 +1,76 @@
+-- name: UpsertFranchise :exec
+-- Record a franchise synced from a Sleeper roster, updating its name and owner
+INSERT INTO franchises (id, name, owner_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE
+SET name = EXCLUDED.name,
+    owner_id = EXCLUDED.owner_id,
+    updated_at = NOW();
+
+-- name: InsertFranchiseIfMissing :exec
+-- Record a franchise from a past season without replacing the current name and owner
+INSERT INTO franchises (id, name, owner_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: GetFranchises :many
+SELECT * FROM franchises ORDER BY id;
+
+-- name: GetFranchiseByUserID :one
+-- The franchise a Sleeper user owns or co-owns
+SELECT f.* FROM franchises f
+JOIN franchise_managers m ON m.franchise_id = f.id
+WHERE m.user_id = $1
+LIMIT 1;
+
+-- name: GetFranchiseManagers :many
+SELECT * FROM franchise_managers ORDER BY franchise_id, user_id;
+
+-- name: DeleteFranchiseManagers :exec
+-- Clear a franchise's managers before recording the current owner and co-owners
+DELETE FROM franchise_managers WHERE franchise_id = $1;
+
+-- name: InsertFranchiseManager :exec
+INSERT INTO franchise_managers (franchise_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: UpsertFranchiseOwner :exec
+-- Record who owned a franchise in a season
+INSERT INTO franchise_owners (franchise_id, year, user_id, team_name)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (franchise_id, year) DO UPDATE
+SET user_id = EXCLUDED.user_id,
+    team_name = EXCLUDED.team_name;
+
+-- name: GetFranchiseOwners :many
+-- Everyone who has owned a franchise, oldest season first
+SELECT * FROM franchise_owners
+WHERE franchise_id = $1
+ORDER BY year;
+
+-- name: GetFranchiseOwnersByYear :many
+-- Who owned each franchise in a season
+SELECT * FROM franchise_owners
+WHERE year = $1
+ORDER BY franchise_id;
+
+-- name: DeleteFranchiseCoOwners :exec
+-- Clear who co-owned a franchise in a season before recording the season's co-owners
+DELETE FROM franchise_co_owners WHERE franchise_id = $1 AND year = $2;
+
+-- name: InsertFranchiseCoOwner :exec
+-- Record a Sleeper user who co-owned a franchise in a season
+INSERT INTO franchise_co_owners (franchise_id, year, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: GetFranchiseSeasonsByUserID :many
+-- Every season a Sleeper user owned or co-owned a franchise, with who owned it that season, oldest first
+SELECT o.* FROM franchise_owners o
+WHERE o.user_id = $1
+   OR EXISTS (
+       SELECT 1 FROM franchise_co_owners c
+       WHERE c.franchise_id = o.franchise_id AND c.year = o.year AND c.user_id = $1
+   )
+ORDER BY o.year;
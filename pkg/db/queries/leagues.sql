@@ -21,3 +21,19 @@ SELECT * FROM (
 ) AS combined
 LIMIT 1;
 
+
+-- name: CompleteLeague :exec
+UPDATE leagues
+SET status = 'COMPLETE',
+    first_place = $2,
+    second_place = $3,
+    third_place = $4
+WHERE id = $1;
+
+-- name: GetLeagueYears :many
+SELECT year FROM leagues
+WHERE status != 'PENDING'
+ORDER BY year ASC;
+
+-- name: UpdateLeagueStatus :exec
+UPDATE leagues SET status = $2 WHERE year = $1;
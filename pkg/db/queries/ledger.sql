@@ -0,0 +1,52 @@
+-- name: GetPayoutRulesByYear :one
+SELECT * FROM season_payout_rules WHERE year = $1;
+
+-- name: UpsertPayoutRules :exec
+INSERT INTO season_payout_rules (year, buy_in, weekly_high_score, first_place, second_place, third_place)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (year) DO UPDATE
+SET buy_in = EXCLUDED.buy_in,
+    weekly_high_score = EXCLUDED.weekly_high_score,
+    first_place = EXCLUDED.first_place,
+    second_place = EXCLUDED.second_place,
+    third_place = EXCLUDED.third_place;
+
+-- name: InsertLedgerEntry :exec
+-- Buy-ins and payouts are idempotent so re-running a sync never double counts money, and picks up changed payout rules
+INSERT INTO ledger_entries (year, user_id, entry_type, week, amount, note)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (year, user_id, entry_type, week) WHERE entry_type <> 'ADJUSTMENT' DO UPDATE
+SET amount = EXCLUDED.amount;
+
+-- name: DeleteSeasonPayouts :exec
+-- Clear a season's weekly high score and podium payouts before settling it again, so payouts that moved to
+-- someone else aren't left behind. Buy-ins (which dues payments are made against) and adjustments are kept.
+DELETE FROM ledger_entries
+WHERE year = $1 AND entry_type NOT IN ('BUY_IN', 'ADJUSTMENT');
+
+-- name: GetLedgerEntriesByUserAndYear :many
+SELECT * FROM ledger_entries
+WHERE user_id = $1 AND year = $2
+ORDER BY week ASC, created_at ASC;
+
+-- name: GetLedgerBalancesByYear :many
+SELECT
+    l.user_id,
+    u.name AS user_name,
+    COALESCE(SUM(l.amount), 0)::INTEGER AS balance
+FROM ledger_entries l
+JOIN users u ON u.id = l.user_id
+WHERE l.year = $1
+GROUP BY l.user_id, u.name
+ORDER BY balance DESC;
+
+-- name: GetSettledLedgerYears :many
+-- Seasons whose weekly high scores have been recorded in the ledger
+SELECT DISTINCT year FROM ledger_entries
+WHERE entry_type = 'WEEKLY_HIGH_SCORE'
+ORDER BY year ASC;
+
+-- name: GetCareerEarningsByUserID :one
+SELECT COALESCE(SUM(amount), 0)::INTEGER AS earnings
+FROM ledger_entries
+WHERE user_id = $1;
@@ -10,7 +10,8 @@ SELECT
     home_seed,
     away_seed,
     home_score,
-    away_score
+    away_score,
+    scores_corrected
 FROM matchups
 WHERE year = $1
 ORDER BY week ASC, id ASC;
@@ -58,6 +59,12 @@ UPDATE matchups
 SET home_score = $3, away_score = $4
 WHERE year = $1 AND week = $2 AND home_user_id = $5 AND away_user_id = $6;
 
+-- name: CorrectMatchupScores :exec
+-- Corrected scores are kept by later syncs, even if Sleeper disagrees
+UPDATE matchups
+SET home_score = $3, away_score = $4, scores_corrected = TRUE
+WHERE year = $1 AND week = $2 AND home_user_id = $5 AND away_user_id = $6;
+
 -- name: GetMatchupByYearWeekUsers :one
 SELECT * FROM matchups 
 WHERE year = $1 AND week = $2 AND home_user_id = $3 AND away_user_id = $4;
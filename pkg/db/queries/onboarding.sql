@@ -5,9 +5,9 @@ WHERE discord_id = '' OR discord_id IS NULL
 ORDER BY name;
 
 -- name: UpdateUserDiscordID :exec
--- Link a Discord user ID to a Sleeper user account
+-- Link a Discord user ID to a Sleeper user account. Onboarding is completed separately, once the member is set up in Discord
 UPDATE users 
-SET discord_id = $2, onboarding_complete = true 
+SET discord_id = $2, onboarding_complete = false 
 WHERE id = $1 AND (discord_id = '' OR discord_id IS NULL);
 
 -- name: IsUserOnboarded :one
@@ -26,4 +26,22 @@ SELECT * FROM users WHERE discord_id = $1 LIMIT 1;
 SELECT EXISTS(
     SELECT 1 FROM users 
     WHERE id = $1 AND discord_id != '' AND discord_id IS NOT NULL
-) AS is_claimed;
\ No newline at end of file
+) AS is_claimed;
+
+-- name: SetUserDiscordID :exec
+-- Link a Discord user ID to a Sleeper user account, replacing any existing link
+UPDATE users
+SET discord_id = $2, onboarding_complete = false
+WHERE id = $1;
+
+-- name: ClearUserDiscordID :exec
+-- Unlink a Sleeper user account from its Discord user
+UPDATE users
+SET discord_id = '', onboarding_complete = false
+WHERE id = $1;
+
+-- name: CompleteUserOnboarding :exec
+-- Mark the Sleeper user linked to a Discord user as onboarded
+UPDATE users
+SET onboarding_complete = true
+WHERE discord_id = $1 AND discord_id != '';
@@ -0,0 +1,14 @@
+-- name: UpsertPlayerScore :exec
+-- Record a player's score for a week, updating it as stat corrections come in
+INSERT INTO player_scores (year, week, player_id, franchise_id, user_id, points, started)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (year, week, player_id) DO UPDATE
+SET franchise_id = EXCLUDED.franchise_id,
+    user_id = EXCLUDED.user_id,
+    points = EXCLUDED.points,
+    started = EXCLUDED.started;
+
+-- name: GetPlayerScoresByYear :many
+SELECT * FROM player_scores
+WHERE year = $1
+ORDER BY week, player_id;
@@ -0,0 +1,13 @@
+-- name: GetPlayersByIDs :many
+SELECT * FROM players
+WHERE id = ANY(@ids::TEXT[]);
+
+-- name: UpsertPlayer :exec
+-- Record a player fetched from Sleeper, updating their name, position and team
+INSERT INTO players (id, name, position, team)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE
+SET name = EXCLUDED.name,
+    position = EXCLUDED.position,
+    team = EXCLUDED.team,
+    updated_at = NOW();
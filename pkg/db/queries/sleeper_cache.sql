@@ -0,0 +1,13 @@
+-- name: GetSleeperCacheEntry :one
+-- Get a cached Sleeper API response
+SELECT * FROM sleeper_cache
+WHERE key = $1;
+
+-- name: UpsertSleeperCacheEntry :exec
+-- Cache a Sleeper API response, replacing any previous response for the same path
+INSERT INTO sleeper_cache (key, body, fetched_at, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (key) DO UPDATE
+SET body = EXCLUDED.body,
+    fetched_at = EXCLUDED.fetched_at,
+    expires_at = EXCLUDED.expires_at;
@@ -1,2 +1,5 @@
 -- name: GetCareerStatsByDiscordID :one
 SELECT * FROM career_stats WHERE discord_id = $1;
+
+-- name: GetCareerStatsByUserID :one
+SELECT * FROM career_stats WHERE user_id = $1;
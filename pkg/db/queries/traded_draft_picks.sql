@@ -0,0 +1,13 @@
+-- name: UpsertTradedDraftPick :exec
+-- Record who owns a traded draft pick, updating it as the pick changes hands again
+INSERT INTO traded_draft_picks (season, round, original_franchise_id, owner_franchise_id, previous_franchise_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (season, round, original_franchise_id) DO UPDATE
+SET owner_franchise_id = EXCLUDED.owner_franchise_id,
+    previous_franchise_id = EXCLUDED.previous_franchise_id;
+
+-- name: GetTradedDraftPicksFromSeason :many
+-- Get traded picks in the given season's draft and later
+SELECT * FROM traded_draft_picks
+WHERE season >= $1
+ORDER BY season, round, original_franchise_id;
@@ -0,0 +1,48 @@
+-- name: InsertTransaction :exec
+-- Transactions are recorded once, so re-running a sync never posts them twice
+INSERT INTO transactions (id, year, week, type, waiver_bid, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: InsertTransactionPlayer :exec
+INSERT INTO transaction_players (transaction_id, player_id, action, franchise_id, user_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
+
+-- name: InsertTransactionDraftPick :exec
+INSERT INTO transaction_draft_picks (transaction_id, season, round, original_franchise_id, original_user_id, from_user_id, to_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT DO NOTHING;
+
+-- name: GetTransactionsByYear :many
+SELECT * FROM transactions
+WHERE year = $1
+ORDER BY created_at ASC;
+
+-- name: GetUnpostedTransactionsByYear :many
+-- Transactions that haven't been posted to Discord yet, oldest first
+SELECT * FROM transactions
+WHERE year = $1 AND posted_at IS NULL
+ORDER BY created_at ASC;
+
+-- name: HasPostedTransactions :one
+-- Whether any of a season's transactions have been posted to Discord yet
+SELECT EXISTS(
+    SELECT 1 FROM transactions
+    WHERE year = $1 AND posted_at IS NOT NULL
+) AS has_posted;
+
+-- name: GetTransactionPlayersByYear :many
+SELECT p.* FROM transaction_players p
+JOIN transactions t ON t.id = p.transaction_id
+WHERE t.year = $1
+ORDER BY p.transaction_id, p.action, p.player_id;
+
+-- name: GetTransactionDraftPicksByYear :many
+SELECT d.* FROM transaction_draft_picks d
+JOIN transactions t ON t.id = d.transaction_id
+WHERE t.year = $1
+ORDER BY d.transaction_id, d.season, d.round;
+
+-- name: MarkTransactionPosted :exec
+UPDATE transactions SET posted_at = NOW() WHERE id = $1;
@@ -10,3 +10,12 @@ SELECT * FROM users WHERE email != '' AND email IS NOT NULL;
 -- name: InsertUser :exec
 INSERT INTO users (id, name, discord_id, onboarding_complete, email)
 VALUES ($1, $2, $3, $4, $5);
+
+-- name: UpdateUserEmail :exec
+UPDATE users SET email = $2 WHERE id = $1;
+
+-- name: InsertUserIfMissing :exec
+-- Add a Sleeper user found on a roster, leaving existing users untouched
+INSERT INTO users (id, name)
+VALUES ($1, $2)
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,28 @@
+-- name: UpsertWelcomeMessage :exec
+-- Track the welcome message posted for a Discord user, replacing any earlier one
+INSERT INTO welcome_messages (discord_id, channel_id, message_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (discord_id) DO UPDATE
+SET channel_id = EXCLUDED.channel_id,
+    message_id = EXCLUDED.message_id,
+    reminder_count = 0,
+    last_reminded_at = NULL,
+    created_at = NOW();
+
+-- name: GetWelcomeMessages :many
+-- Welcome messages for Discord users who haven't linked a Sleeper account yet, oldest first
+SELECT * FROM welcome_messages
+ORDER BY created_at;
+
+-- name: GetWelcomeMessageByDiscordID :one
+SELECT * FROM welcome_messages WHERE discord_id = $1;
+
+-- name: DeleteWelcomeMessage :exec
+-- Stop tracking a welcome message once its user has linked their account or left the server
+DELETE FROM welcome_messages WHERE discord_id = $1;
+
+-- name: RecordWelcomeReminder :exec
+-- Record that a Discord user was reminded to link their Sleeper account
+UPDATE welcome_messages
+SET reminder_count = reminder_count + 1, last_reminded_at = NOW()
+WHERE discord_id = $1;
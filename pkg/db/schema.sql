@@ -24,9 +24,12 @@ CREATE TABLE IF NOT EXISTS matchups (
                                         home_seed INTEGER,                                      -- Playoff seed for home team
                                         away_seed INTEGER,                                      -- Playoff seed for away team
                                         home_score FLOAT NOT NULL,                              -- Total score for the home team
-                                        away_score FLOAT NOT NULL                               -- Total score for the away team
+                                        away_score FLOAT NOT NULL,                              -- Total score for the away team
+                                        scores_corrected BOOLEAN DEFAULT FALSE NOT NULL         -- Whether the commissioner corrected the scores, so syncs leave them alone
 );
 
+ALTER TABLE matchups ADD COLUMN IF NOT EXISTS scores_corrected BOOLEAN DEFAULT FALSE NOT NULL;
+
 create table if not exists leagues (
                                        id text primary key,                                          -- Sleeper League ID
                                        year integer not null,                                        -- Year that league started (e.g., 2023 or 2024)
@@ -162,3 +165,176 @@ SELECT
 FROM users u
          JOIN matchups m ON m.home_user_id = u.id OR m.away_user_id = u.id
 GROUP BY u.id, u.name, u.discord_id;
+
+CREATE TABLE IF NOT EXISTS season_payout_rules (
+                                                   year INTEGER PRIMARY KEY,                  -- Season the rules apply to (e.g., 2024)
+                                                   buy_in INTEGER NOT NULL,                   -- Buy-in owed by each member, in dollars
+                                                   weekly_high_score INTEGER NOT NULL,        -- Payout for each regular season weekly high score
+                                                   first_place INTEGER NOT NULL,              -- Payout for the champion
+                                                   second_place INTEGER NOT NULL,             -- Payout for the runner-up
+                                                   third_place INTEGER NOT NULL,              -- Payout for the third place finisher
+                                                   created_at TIMESTAMPTZ DEFAULT NOW()       -- Timestamp when the rules were recorded
+);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+                                              id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,   -- Unique ID for each ledger entry
+                                              year INTEGER NOT NULL,                            -- Season the entry belongs to
+                                              user_id TEXT NOT NULL REFERENCES users(id),       -- User the money is owed to (positive) or from (negative)
+                                              entry_type TEXT NOT NULL,                         -- BUY_IN, WEEKLY_HIGH_SCORE, FIRST_PLACE, SECOND_PLACE, THIRD_PLACE, ADJUSTMENT
+                                              week INTEGER DEFAULT 0 NOT NULL,                  -- Week the entry relates to (0 for season-level entries)
+                                              amount INTEGER NOT NULL,                          -- Amount in dollars; buy-ins are negative, payouts are positive
+                                              note TEXT DEFAULT '' NOT NULL,                    -- Free-form note (used for adjustments)
+                                              created_at TIMESTAMPTZ DEFAULT NOW()              -- Timestamp when the entry was recorded
+);
+
+-- Buy-ins and payouts are recorded at most once per user, season and week; adjustments are unrestricted
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ledger_entries_unique_payout ON ledger_entries(year, user_id, entry_type, week) WHERE entry_type <> 'ADJUSTMENT';
+
+-- Create index for efficient per-user ledger lookups
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_user_id ON ledger_entries(user_id);
+
+CREATE TABLE IF NOT EXISTS dues_payments (
+                                             id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,   -- Unique ID for each payment
+                                             year INTEGER NOT NULL,                            -- Season the buy-in was paid for
+                                             user_id TEXT NOT NULL REFERENCES users(id),       -- User who paid
+                                             amount INTEGER NOT NULL,                          -- Amount paid in dollars
+                                             recorded_by TEXT DEFAULT '' NOT NULL,             -- Discord ID of the commissioner who marked the payment
+                                             paid_at TIMESTAMPTZ DEFAULT NOW()                 -- Timestamp when the payment was recorded
+);
+
+-- Each user has at most one payment record per season
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dues_payments_year_user ON dues_payments(year, user_id);
+
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+                                               id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,   -- Unique ID for each admin action
+                                               actor_discord_id TEXT NOT NULL,                   -- Discord ID of the commissioner who took the action
+                                               action TEXT NOT NULL,                             -- SYNC, REPOST_RECAP, SET_EMAIL, LINK_USER, UNLINK_USER, SET_LEAGUE_STATUS, CORRECT_MATCHUP
+                                               details TEXT DEFAULT '' NOT NULL,                 -- What was changed, including previous values where there were any
+                                               created_at TIMESTAMPTZ DEFAULT NOW()              -- Timestamp when the action was taken
+);
+
+-- Create index for listing the most recent admin actions
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_created_at ON admin_audit_log(created_at DESC);
+
+CREATE TABLE IF NOT EXISTS welcome_messages (
+                                                discord_id TEXT PRIMARY KEY,                      -- Discord user the welcome message was sent to
+                                                channel_id TEXT NOT NULL,                         -- DM channel or private thread the welcome message was sent in
+                                                message_id TEXT NOT NULL,                         -- The welcome message, so it can be refreshed and cleaned up
+                                                reminder_count INTEGER DEFAULT 0 NOT NULL,        -- Number of reminders sent to link a Sleeper account
+                                                last_reminded_at TIMESTAMPTZ,                     -- Timestamp of the most recent reminder
+                                                created_at TIMESTAMPTZ DEFAULT NOW()              -- Timestamp when the welcome message was sent
+);
+
+CREATE TABLE IF NOT EXISTS franchises (
+                                          id INTEGER PRIMARY KEY,                           -- Sleeper roster ID, which a dynasty league keeps from season to season
+                                          name TEXT DEFAULT '' NOT NULL,                    -- Team name from Sleeper
+                                          owner_id TEXT NOT NULL REFERENCES users(id),      -- Sleeper user who owns the roster; matchups, standings and the ledger are recorded under this user
+                                          updated_at TIMESTAMPTZ DEFAULT NOW()              -- Timestamp when the franchise was last synced from Sleeper
+);
+
+CREATE TABLE IF NOT EXISTS franchise_managers (
+                                                  franchise_id INTEGER NOT NULL REFERENCES franchises(id),  -- Franchise the user manages
+                                                  user_id TEXT NOT NULL REFERENCES users(id),               -- Sleeper user who owns or co-owns the franchise
+                                                  PRIMARY KEY (franchise_id, user_id)
+);
+
+-- Create index for looking up the franchise a user manages
+CREATE INDEX IF NOT EXISTS idx_franchise_managers_user_id ON franchise_managers(user_id);
+
+CREATE TABLE IF NOT EXISTS franchise_owners (
+                                                franchise_id INTEGER NOT NULL REFERENCES franchises(id),  -- Franchise that was owned
+                                                year INTEGER NOT NULL,                                    -- Season the user owned the franchise
+                                                user_id TEXT NOT NULL REFERENCES users(id),               -- Sleeper user who owned the roster that season; its matchups are recorded under this user
+                                                team_name TEXT DEFAULT '' NOT NULL,                       -- Team name from Sleeper that season
+                                                PRIMARY KEY (franchise_id, year)
+);
+
+CREATE TABLE IF NOT EXISTS franchise_co_owners (
+                                                   franchise_id INTEGER NOT NULL REFERENCES franchises(id),  -- Franchise that was co-owned
+                                                   year INTEGER NOT NULL,                                    -- Season the user co-owned the franchise
+                                                   user_id TEXT NOT NULL REFERENCES users(id),               -- Sleeper user who co-owned the roster that season
+                                                   PRIMARY KEY (franchise_id, year, user_id)
+);
+
+-- Create index for looking up the seasons a user co-owned a franchise
+CREATE INDEX IF NOT EXISTS idx_franchise_co_owners_user_id ON franchise_co_owners(user_id);
+
+CREATE TABLE IF NOT EXISTS players (
+                                       id TEXT PRIMARY KEY,                     -- Sleeper player ID (the team abbreviation for defenses)
+                                       name TEXT NOT NULL,                      -- Player's full name
+                                       position TEXT DEFAULT '' NOT NULL,       -- Position (e.g., QB, WR, DEF)
+                                       team TEXT DEFAULT '' NOT NULL,           -- NFL team abbreviation, empty for free agents
+                                       updated_at TIMESTAMPTZ DEFAULT NOW()     -- Timestamp when the player was last fetched from Sleeper
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+                                            id TEXT PRIMARY KEY,                     -- Sleeper transaction ID
+                                            year INTEGER NOT NULL,                   -- Season of the transaction
+                                            week INTEGER NOT NULL,                   -- Week of the transaction
+                                            type TEXT NOT NULL,                      -- trade, waiver or free_agent
+                                            waiver_bid INTEGER DEFAULT 0 NOT NULL,   -- FAAB bid for waiver claims, in dollars
+                                            created_at TIMESTAMPTZ NOT NULL,         -- Timestamp when the transaction was made in Sleeper
+                                            posted_at TIMESTAMPTZ                    -- Timestamp when the transaction was posted to Discord (NULL until then)
+);
+
+-- Create index for efficient per-season transaction lookups
+CREATE INDEX IF NOT EXISTS idx_transactions_year ON transactions(year);
+
+CREATE TABLE IF NOT EXISTS transaction_players (
+                                                   transaction_id TEXT NOT NULL REFERENCES transactions(id),  -- Transaction the player moved in
+                                                   player_id TEXT NOT NULL,                                   -- Sleeper player ID
+                                                   action TEXT NOT NULL,                                      -- ADD or DROP
+                                                   franchise_id INTEGER NOT NULL,                             -- Sleeper roster ID the player joined or left
+                                                   user_id TEXT DEFAULT '' NOT NULL,                          -- Sleeper user who owned the roster at the time
+                                                   PRIMARY KEY (transaction_id, player_id, action)
+);
+
+CREATE TABLE IF NOT EXISTS transaction_draft_picks (
+                                                       transaction_id TEXT NOT NULL REFERENCES transactions(id),  -- Trade the pick changed hands in
+                                                       season INTEGER NOT NULL,                                   -- Season of the draft
+                                                       round INTEGER NOT NULL,                                    -- Round of the pick
+                                                       original_franchise_id INTEGER NOT NULL,                    -- Sleeper roster ID the pick originally belonged to
+                                                       original_user_id TEXT DEFAULT '' NOT NULL,                 -- Sleeper user who owned that roster at the time
+                                                       from_user_id TEXT DEFAULT '' NOT NULL,                     -- Sleeper user who traded the pick away
+                                                       to_user_id TEXT DEFAULT '' NOT NULL,                       -- Sleeper user who received the pick
+                                                       PRIMARY KEY (transaction_id, season, round, original_franchise_id)
+);
+
+CREATE TABLE IF NOT EXISTS player_scores (
+                                             year INTEGER NOT NULL,                       -- Season of the score
+                                             week INTEGER NOT NULL,                       -- Week of the score
+                                             player_id TEXT NOT NULL,                     -- Sleeper player ID
+                                             franchise_id INTEGER NOT NULL,               -- Sleeper roster ID the player was on that week
+                                             user_id TEXT DEFAULT '' NOT NULL,            -- Sleeper user who owned the roster that week
+                                             points FLOAT NOT NULL,                       -- Fantasy points the player scored
+                                             started BOOLEAN DEFAULT FALSE NOT NULL,      -- Whether the player was in the starting lineup
+                                             PRIMARY KEY (year, week, player_id)
+);
+
+CREATE TABLE IF NOT EXISTS draft_picks (
+                                           year INTEGER NOT NULL,                       -- Season of the draft
+                                           pick_no INTEGER NOT NULL,                    -- Overall pick number, starting at 1
+                                           round INTEGER NOT NULL,                      -- Round of the pick
+                                           draft_slot INTEGER NOT NULL,                 -- Slot in the round's draft order
+                                           player_id TEXT NOT NULL,                     -- Sleeper player ID
+                                           franchise_id INTEGER NOT NULL,               -- Sleeper roster ID that made the pick
+                                           user_id TEXT DEFAULT '' NOT NULL,            -- Sleeper user who made the pick
+                                           is_keeper BOOLEAN DEFAULT FALSE NOT NULL,    -- Whether the pick was spent keeping a player
+                                           PRIMARY KEY (year, pick_no)
+);
+
+CREATE TABLE IF NOT EXISTS traded_draft_picks (
+                                                  season INTEGER NOT NULL,                     -- Season of the draft the pick is in
+                                                  round INTEGER NOT NULL,                      -- Round of the pick
+                                                  original_franchise_id INTEGER NOT NULL,      -- Sleeper roster ID the pick originally belonged to
+                                                  owner_franchise_id INTEGER NOT NULL,         -- Sleeper roster ID that owns the pick now
+                                                  previous_franchise_id INTEGER NOT NULL,      -- Sleeper roster ID that last traded the pick away
+                                                  PRIMARY KEY (season, round, original_franchise_id)
+);
+
+CREATE TABLE IF NOT EXISTS sleeper_cache (
+                                             key TEXT PRIMARY KEY,                        -- Sleeper API path the response was fetched from
+                                             body BYTEA NOT NULL,                         -- Response body
+                                             fetched_at TIMESTAMPTZ NOT NULL,             -- When the response was fetched
+                                             expires_at TIMESTAMPTZ                       -- When the response should be fetched again, NULL if never
+);
@@ -114,3 +114,34 @@ func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) error {
 	)
 	return err
 }
+
+const insertUserIfMissing = `-- name: InsertUserIfMissing :exec
+INSERT INTO users (id, name)
+VALUES ($1, $2)
+ON CONFLICT (id) DO NOTHING
+`
+
+type InsertUserIfMissingParams struct {
+	ID   string
+	Name string
+}
+
+// Add a Sleeper user found on a roster, leaving existing users untouched
+func (q *Queries) InsertUserIfMissing(ctx context.Context, arg InsertUserIfMissingParams) error {
+	_, err := q.db.Exec(ctx, insertUserIfMissing, arg.ID, arg.Name)
+	return err
+}
+
+const updateUserEmail = `-- name: UpdateUserEmail :exec
+UPDATE users SET email = $2 WHERE id = $1
+`
+
+type UpdateUserEmailParams struct {
+	ID    string
+	Email string
+}
+
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error {
+	_, err := q.db.Exec(ctx, updateUserEmail, arg.ID, arg.Email)
+	return err
+}
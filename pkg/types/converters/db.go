@@ -10,10 +10,11 @@ import (
 // User conversions
 func UserFromDB(u db.User) domain.User {
 	return domain.User{
-		ID:        u.ID,
-		Name:      u.Name,
-		DiscordID: u.DiscordID,
-		Email:     u.Email,
+		ID:                 u.ID,
+		Name:               u.Name,
+		DiscordID:          u.DiscordID,
+		Email:              u.Email,
+		OnboardingComplete: u.OnboardingComplete.Bool,
 	}
 }
 
@@ -131,3 +132,240 @@ func CareerStatsFromDB(stat db.CareerStat) domain.CareerStats {
 
 	return stats
 }
+
+// Ledger conversions
+func PayoutRulesFromDB(r db.SeasonPayoutRule) domain.PayoutRules {
+	return domain.PayoutRules{
+		Year:            int(r.Year),
+		BuyIn:           int(r.BuyIn),
+		WeeklyHighScore: int(r.WeeklyHighScore),
+		FirstPlace:      int(r.FirstPlace),
+		SecondPlace:     int(r.SecondPlace),
+		ThirdPlace:      int(r.ThirdPlace),
+	}
+}
+
+func LedgerEntryFromDB(e db.LedgerEntry) domain.LedgerEntry {
+	return domain.LedgerEntry{
+		Year:   int(e.Year),
+		UserID: e.UserID,
+		Type:   e.EntryType,
+		Week:   int(e.Week),
+		Amount: int(e.Amount),
+		Note:   e.Note,
+	}
+}
+
+func LedgerEntriesFromDB(entries []db.LedgerEntry) domain.LedgerEntries {
+	var result domain.LedgerEntries
+	for _, e := range entries {
+		result = append(result, LedgerEntryFromDB(e))
+	}
+	return result
+}
+
+func LedgerBalancesFromDB(rows []db.GetLedgerBalancesByYearRow) domain.LedgerBalances {
+	var result domain.LedgerBalances
+	for _, r := range rows {
+		result = append(result, domain.LedgerBalance{
+			UserID:   r.UserID,
+			UserName: r.UserName,
+			Balance:  int(r.Balance),
+		})
+	}
+	return result
+}
+
+// Dues conversions
+func DuesStatusesFromDB(rows []db.GetDuesStatusByYearRow) domain.DuesStatuses {
+	var result domain.DuesStatuses
+	for _, r := range rows {
+		result = append(result, domain.DuesStatus{
+			UserID:     r.UserID,
+			UserName:   r.UserName,
+			DiscordID:  r.DiscordID,
+			Email:      r.Email,
+			AmountDue:  int(r.AmountDue),
+			AmountPaid: int(r.AmountPaid),
+		})
+	}
+	return result
+}
+
+// Admin audit log conversions
+func AdminAuditLogFromDB(rows []db.AdminAuditLog) domain.AdminAuditLog {
+	var result domain.AdminAuditLog
+	for _, r := range rows {
+		result = append(result, domain.AdminAuditEntry{
+			ActorDiscordID: r.ActorDiscordID,
+			Action:         r.Action,
+			Details:        r.Details,
+			CreatedAt:      r.CreatedAt.Time,
+		})
+	}
+	return result
+}
+
+// Welcome message conversions
+func WelcomeMessageFromDB(m db.WelcomeMessage) domain.WelcomeMessage {
+	return domain.WelcomeMessage{
+		DiscordID:      m.DiscordID,
+		ChannelID:      m.ChannelID,
+		MessageID:      m.MessageID,
+		ReminderCount:  int(m.ReminderCount),
+		LastRemindedAt: m.LastRemindedAt.Time,
+		CreatedAt:      m.CreatedAt.Time,
+	}
+}
+
+func WelcomeMessagesFromDB(messages []db.WelcomeMessage) domain.WelcomeMessages {
+	var result domain.WelcomeMessages
+	for _, m := range messages {
+		result = append(result, WelcomeMessageFromDB(m))
+	}
+	return result
+}
+
+// Franchise conversions. Managers are matched to their users, owner first.
+func FranchisesFromDB(franchises []db.Franchise, managers []db.FranchiseManager, users []db.User) domain.Franchises {
+	userMap := UsersToUserMap(users)
+
+	var result domain.Franchises
+	for _, f := range franchises {
+		franchise := domain.Franchise{
+			ID:      int(f.ID),
+			Name:    f.Name,
+			OwnerID: f.OwnerID,
+		}
+		if owner, ok := userMap[f.OwnerID]; ok {
+			franchise.Managers = append(franchise.Managers, owner)
+		}
+		for _, m := range managers {
+			if m.FranchiseID != f.ID || m.UserID == f.OwnerID {
+				continue
+			}
+			if user, ok := userMap[m.UserID]; ok {
+				franchise.Managers = append(franchise.Managers, user)
+			}
+		}
+		result = append(result, franchise)
+	}
+	return result
+}
+
+// FranchiseOwnersFromDB converts a franchise's owners, keeping their order
+func FranchiseOwnersFromDB(owners []db.FranchiseOwner) []domain.FranchiseOwner {
+	result := make([]domain.FranchiseOwner, len(owners))
+	for idx, o := range owners {
+		result[idx] = domain.FranchiseOwner{
+			Year:     int(o.Year),
+			UserID:   o.UserID,
+			TeamName: o.TeamName,
+		}
+	}
+	return result
+}
+
+// PlayersFromDB converts players to a map by Sleeper player ID
+func PlayersFromDB(players []db.Player) domain.PlayerMap {
+	result := make(domain.PlayerMap, len(players))
+	for _, p := range players {
+		result[p.ID] = domain.Player{
+			ID:       p.ID,
+			Name:     p.Name,
+			Position: p.Position,
+			Team:     p.Team,
+		}
+	}
+	return result
+}
+
+// TransactionsFromDB converts transactions along with their players and draft picks, naming players from the map
+func TransactionsFromDB(transactions []db.Transaction, players []db.TransactionPlayer, picks []db.TransactionDraftPick, playerMap domain.PlayerMap) domain.Transactions {
+	var result domain.Transactions
+	for _, t := range transactions {
+		transaction := domain.Transaction{
+			ID:        t.ID,
+			Year:      int(t.Year),
+			Week:      int(t.Week),
+			Type:      t.Type,
+			WaiverBid: int(t.WaiverBid),
+			CreatedAt: t.CreatedAt.Time,
+		}
+		for _, p := range players {
+			if p.TransactionID != t.ID {
+				continue
+			}
+			transaction.Players = append(transaction.Players, domain.TransactionPlayer{
+				Player:      playerMap.Get(p.PlayerID),
+				Action:      p.Action,
+				FranchiseID: int(p.FranchiseID),
+				UserID:      p.UserID,
+			})
+		}
+		for _, p := range picks {
+			if p.TransactionID != t.ID {
+				continue
+			}
+			transaction.DraftPicks = append(transaction.DraftPicks, domain.TransactionDraftPick{
+				Season:              int(p.Season),
+				Round:               int(p.Round),
+				OriginalFranchiseID: int(p.OriginalFranchiseID),
+				OriginalUserID:      p.OriginalUserID,
+				FromUserID:          p.FromUserID,
+				ToUserID:            p.ToUserID,
+			})
+		}
+		result = append(result, transaction)
+	}
+	return result
+}
+
+// PlayerScoresFromDB converts player scores, keeping their order
+func PlayerScoresFromDB(scores []db.PlayerScore) domain.PlayerScores {
+	result := make(domain.PlayerScores, len(scores))
+	for idx, s := range scores {
+		result[idx] = domain.PlayerScore{
+			Year:        int(s.Year),
+			Week:        int(s.Week),
+			PlayerID:    s.PlayerID,
+			FranchiseID: int(s.FranchiseID),
+			UserID:      s.UserID,
+			Points:      s.Points,
+			Started:     s.Started,
+		}
+	}
+	return result
+}
+
+// DraftPicksFromDB converts draft picks, keeping their order and naming players from the map
+func DraftPicksFromDB(picks []db.DraftPick, playerMap domain.PlayerMap) domain.DraftPicks {
+	result := make(domain.DraftPicks, len(picks))
+	for idx, p := range picks {
+		result[idx] = domain.DraftPick{
+			Year:        int(p.Year),
+			PickNo:      int(p.PickNo),
+			Round:       int(p.Round),
+			DraftSlot:   int(p.DraftSlot),
+			Player:      playerMap.Get(p.PlayerID),
+			FranchiseID: int(p.FranchiseID),
+			UserID:      p.UserID,
+			IsKeeper:    p.IsKeeper,
+		}
+	}
+	return result
+}
+
+// TradedDraftPicksFromDB converts traded draft picks, keeping their order
+func TradedDraftPicksFromDB(picks []db.TradedDraftPick) []domain.FuturePick {
+	result := make([]domain.FuturePick, len(picks))
+	for idx, p := range picks {
+		result[idx] = domain.FuturePick{
+			Season:              int(p.Season),
+			Round:               int(p.Round),
+			OriginalFranchiseID: int(p.OriginalFranchiseID),
+			OwnerFranchiseID:    int(p.OwnerFranchiseID),
+		}
+	}
+	return result
+}
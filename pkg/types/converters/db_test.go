@@ -0,0 +1,28 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/db"
+	"github.com/sam-maryland/any-given-sunday/pkg/types/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDraftPicksFromDB(t *testing.T) {
+	picks := DraftPicksFromDB(
+		[]db.DraftPick{
+			{Year: 2024, PickNo: 1, Round: 1, DraftSlot: 1, PlayerID: "p1", FranchiseID: 1, UserID: "u1"},
+			{Year: 2024, PickNo: 2, Round: 1, DraftSlot: 2, PlayerID: "p2", FranchiseID: 2, UserID: "u2"},
+			{Year: 2024, PickNo: 3, Round: 2, DraftSlot: 2, PlayerID: "p3", FranchiseID: 2, UserID: "u2", IsKeeper: true},
+		},
+		domain.PlayerMap{"p1": {ID: "p1", Name: "Christian McCaffrey", Position: "RB", Team: "SF"}},
+	)
+
+	require.Len(t, picks, 3)
+	assert.Equal(t, "Christian McCaffrey", picks[0].Player.Name)
+	assert.Equal(t, "p2", picks[1].Player.Name, "unknown players are named after their ID")
+	assert.Equal(t, "2.02", picks[2].Label())
+	assert.True(t, picks[2].IsKeeper)
+}
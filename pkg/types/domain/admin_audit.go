@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Admin actions recorded in the audit log
+const (
+	AdminActionSync            = "SYNC"
+	AdminActionRepostRecap     = "REPOST_RECAP"
+	AdminActionSetEmail        = "SET_EMAIL"
+	AdminActionLinkUser        = "LINK_USER"
+	AdminActionUnlinkUser      = "UNLINK_USER"
+	AdminActionSetLeagueStatus = "SET_LEAGUE_STATUS"
+	AdminActionCorrectMatchup  = "CORRECT_MATCHUP"
+	AdminActionSyncFranchises  = "SYNC_FRANCHISES"
+	AdminActionSettleLedger    = "SETTLE_LEDGER"
+	AdminActionSetPayouts      = "SET_PAYOUTS"
+	AdminActionAdjustLedger    = "ADJUST_LEDGER"
+	AdminActionDuesPaid        = "DUES_PAID"
+	AdminActionDuesUnpaid      = "DUES_UNPAID"
+)
+
+// AdminAuditEntry records who took an admin action, what it changed and when.
+type AdminAuditEntry struct {
+	ActorDiscordID string
+	Action         string
+	Details        string
+	CreatedAt      time.Time
+}
+
+type AdminAuditLog []AdminAuditEntry
+
+func (l AdminAuditLog) ToDiscordMessage() string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, "**📝 Admin Audit Log 📝**")
+	fmt.Fprintln(&b)
+
+	if len(l) == 0 {
+		fmt.Fprintln(&b, "No admin actions have been recorded yet.")
+		return b.String()
+	}
+
+	for _, e := range l {
+		fmt.Fprintf(&b, "<t:%d:f> <@%s> **%s** %s\n", e.CreatedAt.Unix(), e.ActorDiscordID, e.Action, e.Details)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DraftPick is a player picked in a season's draft
+type DraftPick struct {
+	Year        int
+	PickNo      int // Overall pick number, starting at 1
+	Round       int
+	DraftSlot   int
+	Player      Player
+	FranchiseID int    // Sleeper roster ID that made the pick
+	UserID      string // Sleeper user who made the pick
+	IsKeeper    bool
+}
+
+// Label returns the pick's round and slot (e.g. "3.07")
+func (p DraftPick) Label() string {
+	return fmt.Sprintf("%d.%02d", p.Round, p.DraftSlot)
+}
+
+type DraftPicks []DraftPick
+
+// DraftPickValue is how a pick's production compares to where it was picked
+type DraftPickValue struct {
+	Pick   DraftPick
+	Points float64 // Points the player scored over the season, for any team
+	Rank   int     // Where the player's points rank among every drafted player, starting at 1
+}
+
+// Value returns how many spots better the player finished than where they were picked.
+// Positive values are bargains and negative values are disappointments.
+func (v DraftPickValue) Value() int {
+	return v.Pick.PickNo - v.Rank
+}
+
+// DraftReport compares every pick in a season's draft against what the player went on to score
+type DraftReport struct {
+	Year  int
+	Picks []DraftPickValue // Picks in draft order
+	Steal *DraftPickValue  // Pick that outperformed its draft slot the most
+	Bust  *DraftPickValue  // Pick that underperformed its draft slot the most
+}
+
+// NewDraftReport ranks every drafted player by their season points and awards the steal of the draft and
+// the biggest bust. Keepers aren't eligible for either award since their cost wasn't chosen at the draft.
+// Players tied on points are ranked in draft order.
+func NewDraftReport(year int, picks DraftPicks, scores PlayerScores) DraftReport {
+	report := DraftReport{Year: year}
+	if len(picks) == 0 {
+		return report
+	}
+
+	for _, p := range picks {
+		report.Picks = append(report.Picks, DraftPickValue{Pick: p, Points: scores.SeasonPoints(p.Player.ID)})
+	}
+
+	ranked := make([]int, len(report.Picks))
+	for idx := range ranked {
+		ranked[idx] = idx
+	}
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return report.Picks[ranked[a]].Points > report.Picks[ranked[b]].Points
+	})
+	for rank, idx := range ranked {
+		report.Picks[idx].Rank = rank + 1
+	}
+
+	for idx, v := range report.Picks {
+		if v.Pick.IsKeeper {
+			continue
+		}
+		if report.Steal == nil || v.Value() > report.Steal.Value() {
+			report.Steal = &report.Picks[idx]
+		}
+		if report.Bust == nil || v.Value() < report.Bust.Value() {
+			report.Bust = &report.Picks[idx]
+		}
+	}
+
+	return report
+}
+
+// ForUser returns the report for just the picks a user made. The steal and bust are kept only if they're the user's.
+func (r DraftReport) ForUser(userID string) DraftReport {
+	report := DraftReport{Year: r.Year}
+	for _, v := range r.Picks {
+		if v.Pick.UserID == userID {
+			report.Picks = append(report.Picks, v)
+		}
+	}
+	if r.Steal != nil && r.Steal.Pick.UserID == userID {
+		report.Steal = r.Steal
+	}
+	if r.Bust != nil && r.Bust.Pick.UserID == userID {
+		report.Bust = r.Bust
+	}
+	return report
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDraftPicks() DraftPicks {
+	return DraftPicks{
+		{Year: 2024, PickNo: 1, Round: 1, DraftSlot: 1, Player: Player{ID: "p1", Name: "Christian McCaffrey", Position: "RB", Team: "SF"}, FranchiseID: 1, UserID: "u1"},
+		{Year: 2024, PickNo: 2, Round: 1, DraftSlot: 2, Player: Player{ID: "p2", Name: "p2"}, FranchiseID: 2, UserID: "u2"},
+		{Year: 2024, PickNo: 3, Round: 2, DraftSlot: 2, Player: Player{ID: "p3", Name: "p3"}, FranchiseID: 2, UserID: "u2", IsKeeper: true},
+		{Year: 2024, PickNo: 4, Round: 2, DraftSlot: 1, Player: Player{ID: "p4", Name: "p4"}, FranchiseID: 1, UserID: "u1"},
+	}
+}
+
+func TestNewDraftReport(t *testing.T) {
+	scores := PlayerScores{
+		{Week: 1, PlayerID: "p1", FranchiseID: 1, Points: 5, Started: true},
+		{Week: 1, PlayerID: "p2", FranchiseID: 2, Points: 20, Started: true},
+		{Week: 2, PlayerID: "p2", FranchiseID: 2, Points: 10, Started: false}, // bench points still count
+		{Week: 1, PlayerID: "p3", FranchiseID: 2, Points: 40, Started: true},
+		{Week: 1, PlayerID: "p4", FranchiseID: 1, Points: 35, Started: true},
+	}
+
+	report := NewDraftReport(2024, testDraftPicks(), scores)
+
+	require.Len(t, report.Picks, 4)
+	assert.Equal(t, 30.0, report.Picks[1].Points)
+	assert.Equal(t, []int{4, 3, 1, 2}, []int{report.Picks[0].Rank, report.Picks[1].Rank, report.Picks[2].Rank, report.Picks[3].Rank})
+
+	// The keeper finished first, but keepers aren't eligible for awards
+	require.NotNil(t, report.Steal)
+	assert.Equal(t, "p4", report.Steal.Pick.Player.ID)
+	assert.Equal(t, 2, report.Steal.Value())
+	require.NotNil(t, report.Bust)
+	assert.Equal(t, "p1", report.Bust.Pick.Player.ID)
+	assert.Equal(t, -3, report.Bust.Value())
+
+	mine := report.ForUser("u2")
+	assert.Len(t, mine.Picks, 2)
+	assert.Nil(t, mine.Steal)
+	assert.Nil(t, mine.Bust)
+}
+
+func TestNewDraftReport_NoPicks(t *testing.T) {
+	report := NewDraftReport(2024, nil, nil)
+
+	assert.Empty(t, report.Picks)
+	assert.Nil(t, report.Steal)
+	assert.Nil(t, report.Bust)
+}
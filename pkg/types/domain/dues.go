@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DuesStatus is a member's buy-in for a season and how much of it has been paid.
+type DuesStatus struct {
+	UserID     string
+	UserName   string
+	DiscordID  string
+	Email      string
+	AmountDue  int
+	AmountPaid int
+}
+
+// Outstanding returns the amount the member still owes (never negative).
+func (d DuesStatus) Outstanding() int {
+	return max(d.AmountDue-d.AmountPaid, 0)
+}
+
+func (d DuesStatus) IsPaid() bool {
+	return d.Outstanding() == 0
+}
+
+// User returns the member the buy-in belongs to
+func (d DuesStatus) User() User {
+	return User{ID: d.UserID, Name: d.UserName, DiscordID: d.DiscordID, Email: d.Email}
+}
+
+// ForManager returns the status addressed to someone managing the member's franchise, such as a co-owner
+func (d DuesStatus) ForManager(manager User) DuesStatus {
+	d.UserName = manager.Name
+	d.DiscordID = manager.DiscordID
+	d.Email = manager.Email
+	return d
+}
+
+type DuesStatuses []DuesStatus
+
+// Unpaid returns the members that still owe part of their buy-in.
+func (ds DuesStatuses) Unpaid() DuesStatuses {
+	var unpaid DuesStatuses
+	for _, d := range ds {
+		if !d.IsPaid() {
+			unpaid = append(unpaid, d)
+		}
+	}
+	return unpaid
+}
+
+func (ds DuesStatuses) ToDiscordMessage(year int) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "**💸 %d Dues 💸**\n\n", year)
+
+	if len(ds) == 0 {
+		fmt.Fprintf(&b, "No buy-ins have been recorded for %d yet.\n", year)
+		return b.String()
+	}
+
+	unpaid := ds.Unpaid()
+	if len(unpaid) == 0 {
+		fmt.Fprintln(&b, "✅ Everyone is paid up. Thank you!")
+		return b.String()
+	}
+
+	fmt.Fprintln(&b, "**Still owes:**")
+	for _, d := range unpaid {
+		fmt.Fprintf(&b, "❌ **%s** - $%d\n", d.UserName, d.Outstanding())
+	}
+
+	fmt.Fprintf(&b, "\n**Paid:** %d/%d members\n", len(ds)-len(unpaid), len(ds))
+
+	return b.String()
+}
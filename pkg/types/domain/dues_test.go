@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuesStatuses_Unpaid(t *testing.T) {
+	statuses := DuesStatuses{
+		{UserID: "user1", UserName: "Alice", AmountDue: 100, AmountPaid: 100},
+		{UserID: "user2", UserName: "Bob", AmountDue: 100, AmountPaid: 40},
+		{UserID: "user3", UserName: "Carl", AmountDue: 100, AmountPaid: 0},
+		{UserID: "user4", UserName: "Dana", AmountDue: 100, AmountPaid: 150},
+	}
+
+	unpaid := statuses.Unpaid()
+
+	assert.Len(t, unpaid, 2)
+	assert.Equal(t, "user2", unpaid[0].UserID)
+	assert.Equal(t, 60, unpaid[0].Outstanding())
+	assert.Equal(t, "user3", unpaid[1].UserID)
+	assert.Equal(t, 100, unpaid[1].Outstanding())
+	assert.Equal(t, 0, statuses[3].Outstanding())
+
+	message := statuses.ToDiscordMessage(2024)
+	assert.Contains(t, message, "**Bob** - $60")
+	assert.Contains(t, message, "**Paid:** 2/4 members")
+}
@@ -0,0 +1,281 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Franchise is a team in the league. Sleeper lets a team be managed by its owner, whose results are recorded
+// for the team, along with any number of co-owners.
+type Franchise struct {
+	ID       int // Sleeper roster ID
+	Name     string
+	OwnerID  string
+	Managers Users // The owner first, then co-owners
+}
+
+type Franchises []Franchise
+
+// ManagerNames lists everyone managing the franchise (e.g. "John Doe & Jane Smith")
+func (f Franchise) ManagerNames() string {
+	names := make([]string, len(f.Managers))
+	for i, manager := range f.Managers {
+		names[i] = manager.Name
+	}
+	return strings.Join(names, " & ")
+}
+
+// DisplayName is the franchise's team name, or its managers when it doesn't have one
+func (f Franchise) DisplayName() string {
+	if f.Name != "" {
+		return f.Name
+	}
+	if len(f.Managers) > 0 {
+		return f.ManagerNames()
+	}
+	return fmt.Sprintf("Team %d", f.ID)
+}
+
+// ForID returns the franchise with a Sleeper roster ID
+func (fs Franchises) ForID(id int) (Franchise, bool) {
+	for _, f := range fs {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return Franchise{}, false
+}
+
+// ForUser returns the franchise a user owns or co-owns
+func (fs Franchises) ForUser(userID string) (Franchise, bool) {
+	for _, f := range fs {
+		for _, manager := range f.Managers {
+			if manager.ID == userID {
+				return f, true
+			}
+		}
+	}
+	return Franchise{}, false
+}
+
+// OwnerOf returns the user whose results are recorded for the franchise a user manages.
+// Users who don't manage a franchise are recorded under their own ID.
+func (fs Franchises) OwnerOf(userID string) string {
+	if f, ok := fs.ForUser(userID); ok {
+		return f.OwnerID
+	}
+	return userID
+}
+
+// ManagersOf returns everyone managing the same franchise as a user, including the user.
+// Users who don't manage a franchise are on their own.
+func (fs Franchises) ManagersOf(user User) Users {
+	if f, ok := fs.ForUser(user.ID); ok {
+		return f.Managers
+	}
+	return Users{user}
+}
+
+// WithManagerNames returns a copy of users where each owner of a co-owned franchise is named after
+// everyone managing it, so standings and recaps credit the whole team
+func (fs Franchises) WithManagerNames(users UserMap) UserMap {
+	named := make(UserMap, len(users))
+	for id, user := range users {
+		named[id] = user
+	}
+	for _, f := range fs {
+		if len(f.Managers) < 2 {
+			continue
+		}
+		if owner, ok := named[f.OwnerID]; ok {
+			owner.Name = f.ManagerNames()
+			named[f.OwnerID] = owner
+		}
+	}
+	return named
+}
+
+// FranchiseOwner is the user who owned a franchise in a season. Matchups are recorded under the owner at the time,
+// so a franchise that changes hands is followed through its owners.
+type FranchiseOwner struct {
+	Year     int
+	UserID   string
+	TeamName string
+}
+
+// FranchiseHistory is a franchise with everyone who has owned it, oldest season first
+type FranchiseHistory struct {
+	Franchise Franchise
+	Owners    []FranchiseOwner
+}
+
+// FranchiseTenure is a run of seasons that a user owned a franchise
+type FranchiseTenure struct {
+	UserID    string
+	FirstYear int
+	LastYear  int
+	TeamName  string // Team name in the last season of the tenure
+}
+
+// Seasons describes the seasons of the tenure (e.g. "2019-2021")
+func (t FranchiseTenure) Seasons() string {
+	if t.FirstYear == t.LastYear {
+		return fmt.Sprint(t.FirstYear)
+	}
+	return fmt.Sprintf("%d-%d", t.FirstYear, t.LastYear)
+}
+
+// OwnerIn returns the user who owned the franchise in a season
+func (h FranchiseHistory) OwnerIn(year int) (string, bool) {
+	for _, owner := range h.Owners {
+		if owner.Year == year {
+			return owner.UserID, true
+		}
+	}
+	return "", false
+}
+
+// Tenures groups the franchise's seasons by owner, oldest first. An owner who returns after someone else
+// has owned the franchise starts a new tenure.
+func (h FranchiseHistory) Tenures() []FranchiseTenure {
+	var tenures []FranchiseTenure
+	for _, owner := range h.Owners {
+		if n := len(tenures); n > 0 && tenures[n-1].UserID == owner.UserID {
+			tenures[n-1].LastYear = owner.Year
+			tenures[n-1].TeamName = owner.TeamName
+			continue
+		}
+		tenures = append(tenures, FranchiseTenure{
+			UserID:    owner.UserID,
+			FirstYear: owner.Year,
+			LastYear:  owner.Year,
+			TeamName:  owner.TeamName,
+		})
+	}
+	return tenures
+}
+
+// OwnerNames lists everyone who has owned the franchise with their seasons (e.g. "John Doe (2019-2021), Jane Smith (2022)")
+func (h FranchiseHistory) OwnerNames(users UserMap) string {
+	var names []string
+	for _, tenure := range h.Tenures() {
+		name := tenure.UserID
+		if user, ok := users[tenure.UserID]; ok {
+			name = user.Name
+		}
+		names = append(names, fmt.Sprintf("%s (%s)", name, tenure.Seasons()))
+	}
+	return strings.Join(names, ", ")
+}
+
+// CareerStats follows the franchise through every owner, counting each season's matchups for whoever owned it then.
+// Matchups should include every matchup of those seasons so that weekly high scores can be found.
+// Earnings come from the ledger and are left for the caller to fill in.
+func (h FranchiseHistory) CareerStats(matchups Matchups) CareerStats {
+	stats := SeasonsCareerStats(h.Owners, matchups)
+	stats.UserID, stats.UserName = h.Franchise.OwnerID, h.Franchise.DisplayName()
+	return stats
+}
+
+// SeasonsCareerStats counts the matchups of the team a manager played for in each season, which are recorded
+// under whoever owned that team then. A manager plays for one team a season, so the seasons can span several
+// franchises, e.g. a manager who owned a team and later co-owned someone else's. Matchups should include every
+// matchup of those seasons so that weekly high scores can be found. Earnings come from the ledger and are left for
+// the caller to fill in, along with who the stats belong to.
+func SeasonsCareerStats(seasons []FranchiseOwner, matchups Matchups) CareerStats {
+	ownerIn := make(map[int]string, len(seasons))
+	for _, season := range seasons {
+		ownerIn[season.Year] = season.UserID
+	}
+
+	type week struct{ year, week int }
+	weeklyHighs := make(map[week]float64)
+	for _, m := range matchups {
+		if m.IsPlayoff {
+			continue
+		}
+		key := week{m.Year, m.Week}
+		weeklyHighs[key] = max(weeklyHighs[key], m.HomeScore, m.AwayScore)
+	}
+
+	var stats CareerStats
+	seasonsPlayed, playoffSeasons := make(map[int]bool), make(map[int]bool)
+	rounds := make(map[string]map[int]bool)
+	var regularWins, regularLosses, regularGames, playoffWins, playoffLosses, playoffGames int
+
+	for _, m := range matchups {
+		ownerID, ok := ownerIn[m.Year]
+		if !ok {
+			continue
+		}
+
+		var score, opponentScore float64
+		switch ownerID {
+		case m.HomeUserID:
+			score, opponentScore = m.HomeScore, m.AwayScore
+		case m.AwayUserID:
+			score, opponentScore = m.AwayScore, m.HomeScore
+		default:
+			continue
+		}
+		seasonsPlayed[m.Year] = true
+
+		if !m.IsPlayoff {
+			regularGames++
+			stats.RegularSeasonPointsFor += score
+			stats.RegularSeasonPointsAgainst += opponentScore
+			stats.HighestRegularSeasonScore = max(stats.HighestRegularSeasonScore, score)
+			if score == weeklyHighs[week{m.Year, m.Week}] {
+				stats.WeeklyHighScores++
+			}
+			if score > opponentScore {
+				regularWins++
+			} else if score < opponentScore {
+				regularLosses++
+			}
+			continue
+		}
+
+		playoffSeasons[m.Year] = true
+		playoffGames++
+		stats.PlayoffPointsFor += score
+		stats.PlayoffPointsAgainst += opponentScore
+		if score > opponentScore {
+			playoffWins++
+		} else if score < opponentScore {
+			playoffLosses++
+		}
+
+		if m.PlayoffRound == nil {
+			continue
+		}
+		round := *m.PlayoffRound
+		if rounds[round] == nil {
+			rounds[round] = make(map[int]bool)
+		}
+		rounds[round][m.Year] = true
+		switch {
+		case round == PlayoffRoundFinals && score > opponentScore:
+			stats.FirstPlaceFinishes++
+		case round == PlayoffRoundFinals && score < opponentScore:
+			stats.SecondPlaceFinishes++
+		case round == PlayoffRoundThirdPlace && score > opponentScore:
+			stats.ThirdPlaceFinishes++
+		}
+	}
+
+	stats.SeasonsPlayed = int64(len(seasonsPlayed))
+	stats.RegularSeasonRecord = fmt.Sprintf("%d-%d", regularWins, regularLosses)
+	if regularGames > 0 {
+		stats.RegularSeasonAvgPoints = stats.RegularSeasonPointsFor / float64(regularGames)
+	}
+	stats.PlayoffAppearances = int64(len(playoffSeasons))
+	stats.PlayoffRecord = fmt.Sprintf("%d-%d", playoffWins, playoffLosses)
+	if playoffGames > 0 {
+		stats.PlayoffAvgPoints = stats.PlayoffPointsFor / float64(playoffGames)
+	}
+	stats.QuarterfinalAppearances = int64(len(rounds[PlayoffRoundQuarterfinals]))
+	stats.SemifinalAppearances = int64(len(rounds[PlayoffRoundSemifinals]))
+	stats.FinalsAppearances = int64(len(rounds[PlayoffRoundFinals]))
+	return stats
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/config"
+)
+
+// futurePickSeasons is how many upcoming drafts Sleeper lets teams trade picks in
+const futurePickSeasons = 3
+
+// KeeperRules are the league's rules for keeping players from one season to the next
+type KeeperRules struct {
+	MaxKeepers   int // Players each team can keep, from Sleeper
+	TaxiSlots    int // Taxi squad spots, from Sleeper. Taxi squad players stay without using a keeper spot.
+	DraftRounds  int // Rounds in the draft, from Sleeper. Undrafted players cost the last round.
+	MaxYears     int // Seasons in a row a player can be kept (0 for no limit)
+	RoundPenalty int // Rounds earlier a kept player costs than the round they were last drafted or kept in
+}
+
+// NewKeeperRules returns the league's keeper rules, using Sleeper's settings and the default rules for the rest
+func NewKeeperRules(maxKeepers, taxiSlots, draftRounds int) KeeperRules {
+	return KeeperRules{
+		MaxKeepers:   maxKeepers,
+		TaxiSlots:    taxiSlots,
+		DraftRounds:  draftRounds,
+		MaxYears:     config.KeeperMaxYears,
+		RoundPenalty: config.KeeperRoundPenalty,
+	}
+}
+
+// Keeper is a rostered player and what it would cost to keep them
+type Keeper struct {
+	Player    Player
+	Round     int  // Draft round the player would cost, or 0 for taxi squad players
+	YearsKept int  // Seasons in a row the franchise has already kept the player
+	Eligible  bool // Whether the player can be kept again
+	Taxi      bool // Whether the player is on the taxi squad
+}
+
+// FuturePick is a draft pick in an upcoming draft
+type FuturePick struct {
+	Season              int
+	Round               int
+	OriginalFranchiseID int    // Sleeper roster ID the pick originally belonged to
+	OwnerFranchiseID    int    // Sleeper roster ID that owns the pick now
+	From                string // Name of the franchise the pick originally belonged to, set for picks acquired in trades
+}
+
+// KeeperRoster is the players on a franchise's roster heading into the keeper deadline
+type KeeperRoster struct {
+	FranchiseID int
+	Players     []string // Sleeper player IDs, including the taxi squad
+	Taxi        []string // Sleeper player IDs on the taxi squad
+}
+
+// FranchiseKeepers is a franchise's keeper options and the upcoming draft picks it owns
+type FranchiseKeepers struct {
+	Franchise Franchise
+	Keepers   []Keeper     // Eligible players first, cheapest to keep (latest round) first
+	Picks     []FuturePick // By season, then round
+}
+
+// KeeperReport is every franchise's keeper options for a season's keeper deadline
+type KeeperReport struct {
+	Year       int // Season the players would be kept for
+	Rules      KeeperRules
+	Franchises []FranchiseKeepers
+}
+
+// NewKeeperReport works out what each rostered player would cost to keep in a season, from every earlier
+// season's draft picks, and which picks in the next few drafts each franchise owns.
+// A player costs the round they were picked in the last draft, whoever picked them, less the round penalty.
+// Years kept only counts seasons in a row the player was kept by the same franchise.
+func NewKeeperReport(year int, rules KeeperRules, franchises Franchises, rosters []KeeperRoster, drafts map[int]DraftPicks, traded []FuturePick, players PlayerMap) KeeperReport {
+	report := KeeperReport{Year: year, Rules: rules}
+
+	owners := make(map[string]int) // Season, round and original franchise -> owning franchise
+	for _, p := range traded {
+		owners[futurePickKey(p.Season, p.Round, p.OriginalFranchiseID)] = p.OwnerFranchiseID
+	}
+
+	for _, roster := range rosters {
+		franchise, ok := franchises.ForID(roster.FranchiseID)
+		if !ok {
+			franchise = Franchise{ID: roster.FranchiseID}
+		}
+		fk := FranchiseKeepers{Franchise: franchise}
+
+		taxi := make(map[string]bool, len(roster.Taxi))
+		for _, id := range roster.Taxi {
+			taxi[id] = true
+		}
+		for _, id := range roster.Players {
+			if taxi[id] {
+				fk.Keepers = append(fk.Keepers, Keeper{Player: players.Get(id), Eligible: true, Taxi: true})
+				continue
+			}
+			fk.Keepers = append(fk.Keepers, rules.keeper(players.Get(id), roster.FranchiseID, year, drafts))
+		}
+		sort.SliceStable(fk.Keepers, func(a, b int) bool {
+			ka, kb := fk.Keepers[a], fk.Keepers[b]
+			if ka.Eligible != kb.Eligible {
+				return ka.Eligible
+			}
+			return ka.Round > kb.Round
+		})
+
+		report.Franchises = append(report.Franchises, fk)
+	}
+
+	// Every franchise owns its own picks unless they've been traded
+	for season := year; season < year+futurePickSeasons; season++ {
+		for round := 1; round <= rules.DraftRounds; round++ {
+			for _, roster := range rosters {
+				owner, ok := owners[futurePickKey(season, round, roster.FranchiseID)]
+				if !ok {
+					owner = roster.FranchiseID
+				}
+				pick := FuturePick{Season: season, Round: round, OriginalFranchiseID: roster.FranchiseID, OwnerFranchiseID: owner}
+				if owner != roster.FranchiseID {
+					pick.From = report.franchiseName(roster.FranchiseID)
+				}
+				for idx := range report.Franchises {
+					if report.Franchises[idx].Franchise.ID == owner {
+						report.Franchises[idx].Picks = append(report.Franchises[idx].Picks, pick)
+					}
+				}
+			}
+		}
+	}
+
+	return report
+}
+
+// keeper works out what it would cost a franchise to keep a player in a season
+func (r KeeperRules) keeper(player Player, franchiseID, year int, drafts map[int]DraftPicks) Keeper {
+	k := Keeper{Player: player, Round: r.DraftRounds}
+	if pick, ok := drafts[year-1].ForPlayer(player.ID); ok {
+		k.Round = pick.Round
+	}
+	k.Round = max(k.Round-r.RoundPenalty, 1)
+
+	for season := year - 1; ; season-- {
+		pick, ok := drafts[season].ForPlayer(player.ID)
+		if !ok || !pick.IsKeeper || pick.FranchiseID != franchiseID {
+			break
+		}
+		k.YearsKept++
+	}
+	k.Eligible = r.MaxYears == 0 || k.YearsKept < r.MaxYears
+	return k
+}
+
+func (r KeeperReport) franchiseName(id int) string {
+	for _, fk := range r.Franchises {
+		if fk.Franchise.ID == id {
+			return fk.Franchise.DisplayName()
+		}
+	}
+	return fmt.Sprintf("Team %d", id)
+}
+
+func futurePickKey(season, round, franchiseID int) string {
+	return fmt.Sprintf("%d:%d:%d", season, round, franchiseID)
+}
+
+// ForPlayer returns the pick a player was taken with
+func (dp DraftPicks) ForPlayer(playerID string) (DraftPick, bool) {
+	for _, p := range dp {
+		if p.Player.ID == playerID {
+			return p, true
+		}
+	}
+	return DraftPick{}, false
+}
+
+// ForUser returns the keeper options of the franchise a user owns
+func (r KeeperReport) ForUser(userID string) KeeperReport {
+	report := KeeperReport{Year: r.Year, Rules: r.Rules}
+	for _, fk := range r.Franchises {
+		if fk.Franchise.OwnerID == userID {
+			report.Franchises = append(report.Franchises, fk)
+		}
+	}
+	return report
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeeperReport(t *testing.T) {
+	rules := KeeperRules{MaxKeepers: 2, TaxiSlots: 1, DraftRounds: 3, MaxYears: 2, RoundPenalty: 1}
+	franchises := Franchises{
+		{ID: 1, Name: "Team One", OwnerID: "u1"},
+		{ID: 2, Name: "Team Two", OwnerID: "u2"},
+	}
+	rosters := []KeeperRoster{
+		{FranchiseID: 1, Players: []string{"kept-twice", "drafted", "rookie"}, Taxi: []string{"rookie"}},
+		{FranchiseID: 2, Players: []string{"kept-once", "traded-for", "undrafted"}},
+	}
+	drafts := map[int]DraftPicks{
+		2023: {
+			{PickNo: 1, Round: 1, Player: Player{ID: "kept-twice", Name: "kept-twice"}, FranchiseID: 1, IsKeeper: true},
+			{PickNo: 2, Round: 1, Player: Player{ID: "kept-once", Name: "kept-once"}, FranchiseID: 1, IsKeeper: true}, // kept by another franchise
+		},
+		2024: {
+			{PickNo: 1, Round: 1, Player: Player{ID: "kept-twice", Name: "kept-twice"}, FranchiseID: 1, IsKeeper: true},
+			{PickNo: 2, Round: 2, Player: Player{ID: "kept-once", Name: "kept-once"}, FranchiseID: 2, IsKeeper: true},
+			{PickNo: 3, Round: 3, Player: Player{ID: "drafted", Name: "drafted"}, FranchiseID: 1},
+			{PickNo: 4, Round: 2, Player: Player{ID: "traded-for", Name: "traded-for"}, FranchiseID: 1},
+		},
+	}
+	traded := []FuturePick{
+		{Season: 2025, Round: 1, OriginalFranchiseID: 2, OwnerFranchiseID: 1},
+	}
+
+	report := NewKeeperReport(2025, rules, franchises, rosters, drafts, traded, PlayerMap{})
+
+	assert.Equal(t, 2025, report.Year)
+	require.Len(t, report.Franchises, 2)
+
+	one := report.Franchises[0]
+	require.Len(t, one.Keepers, 3)
+	assert.Equal(t, Keeper{Player: Player{ID: "drafted", Name: "drafted"}, Round: 2, Eligible: true}, one.Keepers[0])
+	assert.True(t, one.Keepers[1].Taxi, "taxi squad players are listed after players who cost a pick")
+	assert.Equal(t, Keeper{Player: Player{ID: "kept-twice", Name: "kept-twice"}, Round: 1, YearsKept: 2}, one.Keepers[2])
+
+	two := report.Franchises[1]
+	require.Len(t, two.Keepers, 3)
+	assert.Equal(t, "undrafted", two.Keepers[0].Player.ID)
+	assert.Equal(t, 2, two.Keepers[0].Round, "undrafted players cost the last round")
+	assert.Equal(t, "kept-once", two.Keepers[1].Player.ID)
+	assert.Equal(t, 1, two.Keepers[1].YearsKept, "only seasons kept by the same franchise count")
+	assert.True(t, two.Keepers[1].Eligible)
+	assert.Equal(t, "traded-for", two.Keepers[2].Player.ID)
+	assert.Equal(t, 1, two.Keepers[2].Round, "traded players keep the round they were drafted in")
+
+	// 3 seasons of 3 rounds each, plus Team Two's 2025 first
+	assert.Len(t, one.Picks, 10)
+	assert.Equal(t, FuturePick{Season: 2025, Round: 1, OriginalFranchiseID: 2, OwnerFranchiseID: 1, From: "Team Two"}, one.Picks[1])
+	assert.Len(t, two.Picks, 8)
+
+	mine := report.ForUser("u2")
+	require.Len(t, mine.Franchises, 1)
+	assert.Equal(t, 2, mine.Franchises[0].Franchise.ID)
+}
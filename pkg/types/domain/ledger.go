@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sam-maryland/any-given-sunday/pkg/config"
+)
+
+const (
+	LedgerEntryTypeBuyIn           = "BUY_IN"
+	LedgerEntryTypeWeeklyHighScore = "WEEKLY_HIGH_SCORE"
+	LedgerEntryTypeFirstPlace      = "FIRST_PLACE"
+	LedgerEntryTypeSecondPlace     = "SECOND_PLACE"
+	LedgerEntryTypeThirdPlace      = "THIRD_PLACE"
+	LedgerEntryTypeAdjustment      = "ADJUSTMENT"
+)
+
+// PayoutRules are the buy-in and payout amounts (in dollars) for a single season.
+type PayoutRules struct {
+	Year            int
+	BuyIn           int
+	WeeklyHighScore int
+	FirstPlace      int
+	SecondPlace     int
+	ThirdPlace      int
+}
+
+// DefaultPayoutRules returns the league's standard payouts, used for seasons that have no recorded rules.
+func DefaultPayoutRules(year int) PayoutRules {
+	return PayoutRules{
+		Year:            year,
+		BuyIn:           config.PayInBuyIn,
+		WeeklyHighScore: config.PayOutWeeklyHighScore,
+		FirstPlace:      config.PayOutFirstPlace,
+		SecondPlace:     config.PayOutSecondPlace,
+		ThirdPlace:      config.PayOutThirdPlace,
+	}
+}
+
+type LedgerEntry struct {
+	Year   int
+	UserID string
+	Type   string
+	Week   int
+	Amount int // Dollars; negative when the user owes the league
+	Note   string
+}
+
+// Description returns a human-readable label for the entry.
+func (e LedgerEntry) Description() string {
+	switch e.Type {
+	case LedgerEntryTypeBuyIn:
+		return "Buy-in"
+	case LedgerEntryTypeWeeklyHighScore:
+		return fmt.Sprintf("Week %d high score", e.Week)
+	case LedgerEntryTypeFirstPlace:
+		return "Champion payout"
+	case LedgerEntryTypeSecondPlace:
+		return "Runner-up payout"
+	case LedgerEntryTypeThirdPlace:
+		return "Third place payout"
+	case LedgerEntryTypeAdjustment:
+		if e.Note != "" {
+			return fmt.Sprintf("Adjustment (%s)", e.Note)
+		}
+		return "Adjustment"
+	default:
+		return e.Type
+	}
+}
+
+type LedgerEntries []LedgerEntry
+
+// Balance sums all entries. A positive balance means the user is up money for the period.
+func (es LedgerEntries) Balance() int {
+	balance := 0
+	for _, e := range es {
+		balance += e.Amount
+	}
+	return balance
+}
+
+// Ledger is a single user's itemized entries for one season.
+type Ledger struct {
+	UserID   string
+	UserName string
+	Year     int
+	Entries  LedgerEntries
+}
+
+func (l Ledger) ToDiscordMessage() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "**💵 %s's %d Ledger 💵**\n\n", l.UserName, l.Year)
+
+	if len(l.Entries) == 0 {
+		fmt.Fprintf(&b, "No ledger entries recorded for %d yet.\n", l.Year)
+		return b.String()
+	}
+
+	for _, e := range l.Entries {
+		fmt.Fprintf(&b, "• %s: %s\n", e.Description(), FormatDollars(e.Amount))
+	}
+
+	fmt.Fprintf(&b, "\n**Balance:** %s\n", FormatDollars(l.Entries.Balance()))
+
+	return b.String()
+}
+
+// LedgerBalance is a user's net balance for one season.
+type LedgerBalance struct {
+	UserID   string
+	UserName string
+	Balance  int
+}
+
+type LedgerBalances []LedgerBalance
+
+func (lb LedgerBalances) ToDiscordMessage(year int) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "**💵 %d League Ledger 💵**\n\n", year)
+
+	if len(lb) == 0 {
+		fmt.Fprintf(&b, "No ledger entries recorded for %d yet.\n", year)
+		return b.String()
+	}
+
+	for i, balance := range lb {
+		fmt.Fprintf(&b, "%d. **%s** %s\n", i+1, balance.UserName, FormatDollars(balance.Balance))
+	}
+
+	return b.String()
+}
+
+// FormatDollars renders a signed dollar amount, e.g. "+$15" or "-$100".
+func FormatDollars(amount int) string {
+	switch {
+	case amount > 0:
+		return fmt.Sprintf("+$%d", amount)
+	case amount < 0:
+		return fmt.Sprintf("-$%d", -amount)
+	default:
+		return "$0"
+	}
+}
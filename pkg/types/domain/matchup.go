@@ -1,5 +1,7 @@
 package domain
 
+import "math"
+
 const (
 	PlayoffRoundFinals        = "final"
 	PlayoffRoundSemifinals    = "semifinal"
@@ -44,3 +46,20 @@ func (m Matchup) Loser() string {
 }
 
 type Matchups []Matchup
+
+// WeeklyHighScorers returns the users in the matchup whose score equals the week's high score.
+func (m Matchup) WeeklyHighScorers(highScore float64) []string {
+	var users []string
+	if m.HomeScore == highScore {
+		users = append(users, m.HomeUserID)
+	}
+	if m.AwayScore == highScore {
+		users = append(users, m.AwayUserID)
+	}
+	return users
+}
+
+// Margin returns the winning margin of the matchup.
+func (m Matchup) Margin() float64 {
+	return math.Abs(m.HomeScore - m.AwayScore)
+}
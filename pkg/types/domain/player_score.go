@@ -0,0 +1,69 @@
+package domain
+
+import "slices"
+
+// PlayerScore is what a player scored for a team in a week
+type PlayerScore struct {
+	Year        int
+	Week        int
+	PlayerID    string
+	FranchiseID int    // Sleeper roster ID the player was on that week
+	UserID      string // Sleeper user who owned the roster that week
+	Points      float64
+	Started     bool
+}
+
+type PlayerScores []PlayerScore
+
+// StartedPoints returns the points a player scored in a franchise's starting lineup from a week onwards.
+// Weeks the player sat on the bench or spent on another roster don't count.
+func (ps PlayerScores) StartedPoints(playerID string, franchiseID, fromWeek int) float64 {
+	return ps.StartedPointsBetween(playerID, franchiseID, fromWeek, 0)
+}
+
+// StartedPointsBetween returns the points a player scored in a franchise's starting lineup from a week up to,
+// but not including, another week. A toWeek of 0 means through the end of the season.
+func (ps PlayerScores) StartedPointsBetween(playerID string, franchiseID, fromWeek, toWeek int) float64 {
+	var points float64
+	for _, s := range ps {
+		if s.PlayerID != playerID || s.FranchiseID != franchiseID || !s.Started {
+			continue
+		}
+		if s.Week >= fromWeek && (toWeek == 0 || s.Week < toWeek) {
+			points += s.Points
+		}
+	}
+	return points
+}
+
+// StartedPointsInWeek returns the points a player scored in a franchise's starting lineup in a week
+func (ps PlayerScores) StartedPointsInWeek(playerID string, franchiseID, week int) (float64, bool) {
+	for _, s := range ps {
+		if s.PlayerID == playerID && s.FranchiseID == franchiseID && s.Week == week && s.Started {
+			return s.Points, true
+		}
+	}
+	return 0, false
+}
+
+// SeasonPoints returns everything a player scored over the season, whether or not they were started
+func (ps PlayerScores) SeasonPoints(playerID string) float64 {
+	var points float64
+	for _, s := range ps {
+		if s.PlayerID == playerID {
+			points += s.Points
+		}
+	}
+	return points
+}
+
+// BenchPoints totals the points each user's benched players scored in the given weeks
+func (ps PlayerScores) BenchPoints(weeks []int) map[string]float64 {
+	points := make(map[string]float64)
+	for _, s := range ps {
+		if !s.Started && slices.Contains(weeks, s.Week) {
+			points[s.UserID] += s.Points
+		}
+	}
+	return points
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayerScores_BenchPoints(t *testing.T) {
+	scores := PlayerScores{
+		{Week: 1, PlayerID: "p1", UserID: "u1", Points: 20, Started: true},
+		{Week: 1, PlayerID: "p2", UserID: "u1", Points: 8.25, Started: false},
+		{Week: 2, PlayerID: "p2", UserID: "u1", Points: 1, Started: false},
+		{Week: 2, PlayerID: "p3", UserID: "u2", Points: 12.5, Started: false},
+		{Week: 15, PlayerID: "p3", UserID: "u2", Points: 30, Started: false}, // playoffs
+	}
+
+	assert.Equal(t, map[string]float64{"u1": 9.25, "u2": 12.5}, scores.BenchPoints([]int{1, 2}))
+	assert.Empty(t, scores.BenchPoints(nil))
+}
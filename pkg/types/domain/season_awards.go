@@ -0,0 +1,93 @@
+package domain
+
+// SeasonAward is a single end-of-season award. Week is only set for single-week awards.
+type SeasonAward struct {
+	UserID string
+	Week   int
+	Value  float64
+}
+
+// SeasonAwards is the end-of-season awards report for a completed league.
+type SeasonAwards struct {
+	LeagueID        string
+	Year            int
+	Podium          []string        // User IDs of the first, second and third place finishers
+	MVP             *SeasonAward    // Most regular season points for
+	Unluckiest      *SeasonAward    // Most regular season points against
+	BestWeek        *SeasonAward    // Highest single week score
+	HighScoreLeader *SeasonAward    // Most weekly high scores (Value is the count)
+	BiggestBlowout  *Matchup        // Largest regular season winning margin
+	WorstTrade      *TradeGrade     // Most lopsided trade, judged by what each side's players scored afterwards
+	DraftSteal      *DraftPickValue // Pick that outperformed its draft slot the most
+	DraftBust       *DraftPickValue // Pick that underperformed its draft slot the most
+	Payouts         LedgerBalances
+}
+
+// NewSeasonAwards calculates the season awards from a season's matchups. Playoff games are ignored.
+// Ties go to whoever reached the value first (earliest week, then matchup order).
+func NewSeasonAwards(leagueID string, year int, matchups Matchups, podium []string) SeasonAwards {
+	awards := SeasonAwards{
+		LeagueID: leagueID,
+		Year:     year,
+		Podium:   podium,
+	}
+
+	var order []string
+	pointsFor := make(map[string]float64)
+	pointsAgainst := make(map[string]float64)
+	weeklyMax := make(map[int]float64)
+	for _, m := range matchups {
+		if m.IsPlayoff {
+			continue
+		}
+
+		for _, userID := range []string{m.HomeUserID, m.AwayUserID} {
+			if _, ok := pointsFor[userID]; !ok {
+				order = append(order, userID)
+			}
+		}
+		pointsFor[m.HomeUserID] += m.HomeScore
+		pointsFor[m.AwayUserID] += m.AwayScore
+		pointsAgainst[m.HomeUserID] += m.AwayScore
+		pointsAgainst[m.AwayUserID] += m.HomeScore
+		weeklyMax[m.Week] = max(weeklyMax[m.Week], m.HomeScore, m.AwayScore)
+
+		for _, s := range []SeasonAward{
+			{UserID: m.HomeUserID, Week: m.Week, Value: m.HomeScore},
+			{UserID: m.AwayUserID, Week: m.Week, Value: m.AwayScore},
+		} {
+			if awards.BestWeek == nil || s.Value > awards.BestWeek.Value {
+				awards.BestWeek = &s
+			}
+		}
+
+		if awards.BiggestBlowout == nil || m.Margin() > awards.BiggestBlowout.Margin() {
+			blowout := m
+			awards.BiggestBlowout = &blowout
+		}
+	}
+
+	highScores := make(map[string]int)
+	for _, m := range matchups {
+		if m.IsPlayoff {
+			continue
+		}
+		for _, userID := range m.WeeklyHighScorers(weeklyMax[m.Week]) {
+			highScores[userID]++
+		}
+	}
+
+	for _, userID := range order {
+		if awards.MVP == nil || pointsFor[userID] > awards.MVP.Value {
+			awards.MVP = &SeasonAward{UserID: userID, Value: pointsFor[userID]}
+		}
+		if awards.Unluckiest == nil || pointsAgainst[userID] > awards.Unluckiest.Value {
+			awards.Unluckiest = &SeasonAward{UserID: userID, Value: pointsAgainst[userID]}
+		}
+		if count := highScores[userID]; count > 0 && (awards.HighScoreLeader == nil || float64(count) > awards.HighScoreLeader.Value) {
+			awards.HighScoreLeader = &SeasonAward{UserID: userID, Value: float64(count)}
+		}
+	}
+
+	return awards
+}
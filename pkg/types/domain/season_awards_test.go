@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSeasonAwards(t *testing.T) {
+	finals := PlayoffRoundFinals
+	matchups := Matchups{
+		{Year: 2024, Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 120.0, AwayScore: 80.0},
+		{Year: 2024, Week: 1, HomeUserID: "user3", AwayUserID: "user4", HomeScore: 100.0, AwayScore: 110.0},
+		{Year: 2024, Week: 2, HomeUserID: "user1", AwayUserID: "user3", HomeScore: 130.0, AwayScore: 125.0},
+		{Year: 2024, Week: 2, HomeUserID: "user2", AwayUserID: "user4", HomeScore: 150.0, AwayScore: 90.0},
+		{Year: 2024, Week: 15, IsPlayoff: true, PlayoffRound: &finals, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 200.0, AwayScore: 60.0},
+	}
+
+	awards := NewSeasonAwards("league1", 2024, matchups, []string{"user1", "user2", "user4"})
+
+	assert.Equal(t, "league1", awards.LeagueID)
+	assert.Equal(t, []string{"user1", "user2", "user4"}, awards.Podium)
+
+	require.NotNil(t, awards.MVP)
+	assert.Equal(t, SeasonAward{UserID: "user1", Value: 250.0}, *awards.MVP)
+
+	require.NotNil(t, awards.Unluckiest)
+	assert.Equal(t, SeasonAward{UserID: "user4", Value: 250.0}, *awards.Unluckiest)
+
+	require.NotNil(t, awards.BestWeek)
+	assert.Equal(t, SeasonAward{UserID: "user2", Week: 2, Value: 150.0}, *awards.BestWeek)
+
+	require.NotNil(t, awards.BiggestBlowout, "playoff games should not count")
+	assert.Equal(t, 2, awards.BiggestBlowout.Week)
+	assert.Equal(t, "user2", awards.BiggestBlowout.Winner())
+	assert.Equal(t, 60.0, awards.BiggestBlowout.Margin())
+
+	require.NotNil(t, awards.HighScoreLeader)
+	assert.Equal(t, SeasonAward{UserID: "user1", Value: 1}, *awards.HighScoreLeader)
+}
+
+func TestNewSeasonAwards_NoMatchups(t *testing.T) {
+	awards := NewSeasonAwards("league1", 2024, Matchups{}, nil)
+
+	assert.Nil(t, awards.MVP)
+	assert.Nil(t, awards.Unluckiest)
+	assert.Nil(t, awards.BestWeek)
+	assert.Nil(t, awards.BiggestBlowout)
+	assert.Nil(t, awards.HighScoreLeader)
+}
@@ -3,8 +3,6 @@ package domain
 import (
 	"fmt"
 	"strings"
-
-	"github.com/sam-maryland/any-given-sunday/pkg/config"
 )
 
 type CareerStats struct {
@@ -28,6 +26,10 @@ type CareerStats struct {
 	PlayoffPointsFor           float64
 	PlayoffPointsAgainst       float64
 	PlayoffAvgPoints           float64
+	CareerEarnings             int    // Net dollars won across all seasons, from the ledger
+	FranchiseName              string // Team name, when the stats belong to a co-owned franchise
+	Managers                   string // Everyone managing the franchise, when it has co-owners
+	Owners                     string // Everyone who has owned the franchise, when the stats follow a franchise across owners
 }
 
 func (c CareerStats) ToDiscordMessage(username string) string {
@@ -36,64 +38,88 @@ func (c CareerStats) ToDiscordMessage(username string) string {
 	fmt.Fprintf(&b, "**%s's Career Stats** 📊\n\n", username)
 
 	// 🏆 Trophy Case
-	if c.FirstPlaceFinishes > 0 || c.SecondPlaceFinishes > 0 || c.ThirdPlaceFinishes > 0 {
+	if c.hasTrophies() {
 		fmt.Fprintln(&b, "🏆 **Trophy Case:**")
-		if c.FirstPlaceFinishes > 0 {
-			fmt.Fprintf(&b, "   🏆 %dx Champion\n", c.FirstPlaceFinishes)
-		}
-		if c.SecondPlaceFinishes > 0 {
-			fmt.Fprintf(&b, "   🥈 %dx Runner-Up\n", c.SecondPlaceFinishes)
-		}
-		if c.ThirdPlaceFinishes > 0 {
-			fmt.Fprintf(&b, "   🥉 %dx Third Place Finish\n", c.ThirdPlaceFinishes)
-		}
-		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, c.TrophyCase())
 	} else {
-		fmt.Fprintln(&b, "🏆 **Trophy Case:** 🕳️ A black hole of missed opportunities.")
-		fmt.Fprintln(&b)
+		fmt.Fprintf(&b, "🏆 **Trophy Case:** %s\n\n", c.TrophyCase())
 	}
 
 	// 💵 Career Earnings
-	earnings := c.CalculateCareerEarnings() // This should return a float64 or int
+	fmt.Fprintf(&b, "💵 **Career Earnings:** %s\n\n", c.CareerEarningsSummary(username))
+
+	// 🏟️ Regular Season
+	fmt.Fprintf(&b, "🏟️ **Regular Season:** %s\n", c.RegularSeasonRecord)
+	fmt.Fprintf(&b, "%s\n", c.RegularSeasonSummary())
+
+	// 🎯 Playoffs
+	if c.PlayoffAppearances == 0 {
+		fmt.Fprintf(&b, "🎯 **Playoffs:** %s\n\n", c.PlayoffSummary())
+	} else {
+		fmt.Fprintf(&b, "🎯 **Playoffs:** %s (%d appearances)\n", c.PlayoffRecord, c.PlayoffAppearances)
+		fmt.Fprintf(&b, "%s\n", c.PlayoffSummary())
+	}
+
+	return b.String()
+}
+
+func (c CareerStats) hasTrophies() bool {
+	return c.FirstPlaceFinishes > 0 || c.SecondPlaceFinishes > 0 || c.ThirdPlaceFinishes > 0
+}
+
+// TrophyCase lists the user's podium finishes, one per line.
+func (c CareerStats) TrophyCase() string {
+	if !c.hasTrophies() {
+		return "🕳️ A black hole of missed opportunities."
+	}
+
+	var b strings.Builder
+	if c.FirstPlaceFinishes > 0 {
+		fmt.Fprintf(&b, "   🏆 %dx Champion\n", c.FirstPlaceFinishes)
+	}
+	if c.SecondPlaceFinishes > 0 {
+		fmt.Fprintf(&b, "   🥈 %dx Runner-Up\n", c.SecondPlaceFinishes)
+	}
+	if c.ThirdPlaceFinishes > 0 {
+		fmt.Fprintf(&b, "   🥉 %dx Third Place Finish\n", c.ThirdPlaceFinishes)
+	}
+	return b.String()
+}
+
+// CareerEarningsSummary describes the user's net career earnings.
+func (c CareerStats) CareerEarningsSummary(username string) string {
+	earnings := c.CareerEarnings
 	if earnings > 0 {
-		fmt.Fprintf(&b, "💵 **Career Earnings:** **$%d** — %s is rollin’ in 💰\n\n", earnings, username)
+		return fmt.Sprintf("**$%d** — %s is rollin’ in 💰", earnings, username)
 	} else if earnings < 0 {
-		fmt.Fprintf(&b, "💵 **Career Earnings:** ❌ **-$%d** — %s is keeping the league solvent 🐖💥\n\n", -earnings, username)
-	} else {
-		fmt.Fprintf(&b, "💵 **Career Earnings:** **$0** — %s has broken exactly even. Impressive... or lucky? 🤷‍♂️\n\n", username)
+		return fmt.Sprintf("❌ **-$%d** — %s is keeping the league solvent 🐖💥", -earnings, username)
 	}
+	return fmt.Sprintf("**$0** — %s has broken exactly even. Impressive... or lucky? 🤷‍♂️", username)
+}
 
-	// 🏟️ Regular Season
-	fmt.Fprintf(&b, "🏟️ **Regular Season:** %s\n", c.RegularSeasonRecord)
+// RegularSeasonSummary lists the user's regular season stats, one per line.
+func (c CareerStats) RegularSeasonSummary() string {
+	var b strings.Builder
 	fmt.Fprintf(&b, "   ↳ Avg Points: %.1f\n", c.RegularSeasonAvgPoints)
 	fmt.Fprintf(&b, "   ↳ Points For: %.1f\n", c.RegularSeasonPointsFor)
 	fmt.Fprintf(&b, "   ↳ Points Against: %.1f\n", c.RegularSeasonPointsAgainst)
 	fmt.Fprintf(&b, "   ↳ Weekly High Scores: %d\n", c.WeeklyHighScores)
-	fmt.Fprintf(&b, "   ↳ Highest Score: %.1f\n\n", c.HighestRegularSeasonScore)
+	fmt.Fprintf(&b, "   ↳ Highest Score: %.1f\n", c.HighestRegularSeasonScore)
+	return b.String()
+}
 
-	// 🎯 Playoffs
+// PlayoffSummary lists the user's playoff stats, one per line.
+func (c CareerStats) PlayoffSummary() string {
 	if c.PlayoffAppearances == 0 {
-		fmt.Fprintf(&b, "🎯 **Playoffs:** 🫡 Hasn't made the playoffs... yet.\n\n")
-	} else {
-		fmt.Fprintf(&b, "🎯 **Playoffs:** %s (%d appearances)\n",
-			c.PlayoffRecord, c.PlayoffAppearances)
-		fmt.Fprintf(&b, "   ↳ Quarterfinals: %d\n", c.QuarterfinalAppearances)
-		fmt.Fprintf(&b, "   ↳ Semifinals: %d\n", c.SemifinalAppearances)
-		fmt.Fprintf(&b, "   ↳ Finals: %d\n", c.FinalsAppearances)
-		fmt.Fprintf(&b, "   ↳ Avg Points: %.1f\n", c.PlayoffAvgPoints)
-		fmt.Fprintf(&b, "   ↳ Points For: %.1f\n", c.PlayoffPointsFor)
-		fmt.Fprintf(&b, "   ↳ Points Against: %.1f\n\n", c.PlayoffPointsAgainst)
+		return "🫡 Hasn't made the playoffs... yet."
 	}
 
+	var b strings.Builder
+	fmt.Fprintf(&b, "   ↳ Quarterfinals: %d\n", c.QuarterfinalAppearances)
+	fmt.Fprintf(&b, "   ↳ Semifinals: %d\n", c.SemifinalAppearances)
+	fmt.Fprintf(&b, "   ↳ Finals: %d\n", c.FinalsAppearances)
+	fmt.Fprintf(&b, "   ↳ Avg Points: %.1f\n", c.PlayoffAvgPoints)
+	fmt.Fprintf(&b, "   ↳ Points For: %.1f\n", c.PlayoffPointsFor)
+	fmt.Fprintf(&b, "   ↳ Points Against: %.1f\n", c.PlayoffPointsAgainst)
 	return b.String()
 }
-
-func (c CareerStats) CalculateCareerEarnings() int {
-	earnings := 0
-	earnings -= int(c.SeasonsPlayed) * config.PayInBuyIn
-	earnings += int(c.WeeklyHighScores) * config.PayOutWeeklyHighScore
-	earnings += int(c.FirstPlaceFinishes) * config.PayOutFirstPlace
-	earnings += int(c.SecondPlaceFinishes) * config.PayOutSecondPlace
-	earnings += int(c.ThirdPlaceFinishes) * config.PayOutThirdPlace
-	return earnings
-}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// Trade grades, based on a side's share of the points scored by everything that changed hands
+const (
+	TradeGradeA       = "A"
+	TradeGradeB       = "B"
+	TradeGradeC       = "C"
+	TradeGradeD       = "D"
+	TradeGradeF       = "F"
+	TradeGradeUnknown = "—" // None of the traded players have been started since the trade
+)
+
+// TradeSide is what one manager received in a trade and how it has worked out for them
+type TradeSide struct {
+	UserID     string
+	Players    []TransactionPlayer
+	DraftPicks []TransactionDraftPick
+	Points     float64 // Points the received players have scored in the manager's starting lineup since the trade
+	Grade      string
+}
+
+// TradeGrade is a trade graded on how the players each side received have scored since.
+// Draft picks are listed but don't count towards either side.
+type TradeGrade struct {
+	Trade Transaction
+	Sides []TradeSide // Sides in the order they appear in the trade
+}
+
+type TradeGrades []TradeGrade
+
+// Received lists the names of the players and draft picks the side received (e.g. "Ja'Marr Chase, 2026 Round 1 pick")
+func (s TradeSide) Received() string {
+	var names []string
+	for _, p := range s.Players {
+		names = append(names, p.Player.Name)
+	}
+	for _, p := range s.DraftPicks {
+		names = append(names, p.Description())
+	}
+	if len(names) == 0 {
+		return "nothing"
+	}
+	return strings.Join(names, ", ")
+}
+
+// GradeTrades grades every trade in a season's transactions using the season's player scores.
+// A received player's points only count in weeks they were in the receiving team's starting lineup,
+// from the week of the trade onwards.
+func GradeTrades(transactions Transactions, scores PlayerScores) TradeGrades {
+	var grades TradeGrades
+	for _, t := range transactions {
+		if t.Type != TransactionTypeTrade {
+			continue
+		}
+
+		grade := TradeGrade{Trade: t}
+		var total float64
+		for _, userID := range t.UserIDs() {
+			players, picks := t.Received(userID)
+			side := TradeSide{UserID: userID, Players: players, DraftPicks: picks}
+			for _, p := range players {
+				side.Points += scores.StartedPoints(p.Player.ID, p.FranchiseID, t.Week)
+			}
+			total += side.Points
+			grade.Sides = append(grade.Sides, side)
+		}
+
+		for idx := range grade.Sides {
+			grade.Sides[idx].Grade = tradeGrade(grade.Sides[idx].Points, total, len(grade.Sides))
+		}
+		grades = append(grades, grade)
+	}
+	return grades
+}
+
+// tradeGrade grades a side by how its share of the trade's points compares to an even split,
+// so in a two-team trade 70% of the points is an A and 30% is an F
+func tradeGrade(points, total float64, sides int) string {
+	if total == 0 {
+		return TradeGradeUnknown
+	}
+
+	ratio := points / total * float64(sides)
+	switch {
+	case ratio >= 1.4:
+		return TradeGradeA
+	case ratio >= 1.2:
+		return TradeGradeB
+	case ratio >= 0.8:
+		return TradeGradeC
+	case ratio >= 0.6:
+		return TradeGradeD
+	}
+	return TradeGradeF
+}
+
+// Winner returns the side whose received players have scored the most since the trade.
+// It returns false if nothing has been scored or the top sides are tied.
+func (g TradeGrade) Winner() (TradeSide, bool) {
+	sides := g.ranked()
+	if len(sides) < 2 || sides[0].Points == sides[1].Points {
+		return TradeSide{}, false
+	}
+	return sides[0], true
+}
+
+// Loser returns the side whose received players have scored the least since the trade.
+// It returns false if nothing has been scored or the bottom sides are tied.
+func (g TradeGrade) Loser() (TradeSide, bool) {
+	sides := g.ranked()
+	if len(sides) < 2 || sides[len(sides)-1].Points == sides[len(sides)-2].Points {
+		return TradeSide{}, false
+	}
+	return sides[len(sides)-1], true
+}
+
+// Margin returns how many more points the winning side got out of the trade than the losing side
+func (g TradeGrade) Margin() float64 {
+	sides := g.ranked()
+	if len(sides) < 2 {
+		return 0
+	}
+	return sides[0].Points - sides[len(sides)-1].Points
+}
+
+// ranked returns the sides from most to fewest points
+func (g TradeGrade) ranked() []TradeSide {
+	sides := append([]TradeSide(nil), g.Sides...)
+	sort.SliceStable(sides, func(a, b int) bool {
+		return sides[a].Points > sides[b].Points
+	})
+	return sides
+}
+
+// Involves reports whether a user was on either side of the trade
+func (g TradeGrade) Involves(userID string) bool {
+	for _, side := range g.Sides {
+		if side.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// ForUser returns the trades a user was involved in
+func (tg TradeGrades) ForUser(userID string) TradeGrades {
+	var grades TradeGrades
+	for _, g := range tg {
+		if g.Involves(userID) {
+			grades = append(grades, g)
+		}
+	}
+	return grades
+}
+
+// Worst returns the most lopsided trade, or nil if no trade has a loser yet.
+// Ties go to the earlier trade.
+func (tg TradeGrades) Worst() *TradeGrade {
+	var worst *TradeGrade
+	for idx, g := range tg {
+		if _, ok := g.Loser(); !ok {
+			continue
+		}
+		if worst == nil || g.Margin() > worst.Margin() {
+			worst = &tg[idx]
+		}
+	}
+	return worst
+}
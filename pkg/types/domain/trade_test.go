@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTrades() Transactions {
+	saquon := Player{ID: "4866", Name: "Saquon Barkley", Position: "RB", Team: "PHI"}
+	jefferson := Player{ID: "6794", Name: "Justin Jefferson", Position: "WR", Team: "MIN"}
+	return Transactions{
+		{
+			ID: "trade1", Year: 2024, Week: 5, Type: TransactionTypeTrade,
+			Players: []TransactionPlayer{
+				{Player: saquon, Action: TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+				{Player: jefferson, Action: TransactionActionAdd, FranchiseID: 2, UserID: "u2"},
+				{Player: saquon, Action: TransactionActionDrop, FranchiseID: 2, UserID: "u2"},
+				{Player: jefferson, Action: TransactionActionDrop, FranchiseID: 1, UserID: "u1"},
+			},
+			DraftPicks: []TransactionDraftPick{
+				{Season: 2025, Round: 1, OriginalFranchiseID: 3, OriginalUserID: "u3", FromUserID: "u1", ToUserID: "u2"},
+			},
+		},
+		{
+			ID: "waiver1", Year: 2024, Week: 5, Type: TransactionTypeWaiver, WaiverBid: 44,
+			Players: []TransactionPlayer{
+				{Player: Player{ID: "9999", Name: "9999"}, Action: TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+			},
+		},
+	}
+}
+
+func TestGradeTrades(t *testing.T) {
+	// u1 received Saquon Barkley (4866) and u2 received Justin Jefferson (6794) plus a pick in week 5
+	scores := PlayerScores{
+		{Year: 2024, Week: 4, PlayerID: "4866", FranchiseID: 2, UserID: "u2", Points: 40, Started: true},   // before the trade
+		{Year: 2024, Week: 5, PlayerID: "4866", FranchiseID: 1, UserID: "u1", Points: 30, Started: true},   // counts
+		{Year: 2024, Week: 6, PlayerID: "4866", FranchiseID: 1, UserID: "u1", Points: 25.5, Started: true}, // counts
+		{Year: 2024, Week: 7, PlayerID: "4866", FranchiseID: 1, UserID: "u1", Points: 20, Started: false},  // benched
+		{Year: 2024, Week: 5, PlayerID: "6794", FranchiseID: 2, UserID: "u2", Points: 10, Started: true},   // counts
+		{Year: 2024, Week: 6, PlayerID: "6794", FranchiseID: 3, UserID: "u3", Points: 35, Started: true},   // traded on again
+	}
+
+	grades := GradeTrades(testTrades(), scores)
+
+	require.Len(t, grades, 1, "only trades are graded")
+	g := grades[0]
+	require.Len(t, g.Sides, 2)
+
+	assert.Equal(t, "u1", g.Sides[0].UserID)
+	assert.Equal(t, 55.5, g.Sides[0].Points)
+	assert.Equal(t, TradeGradeA, g.Sides[0].Grade)
+	assert.Equal(t, "Saquon Barkley", g.Sides[0].Received())
+
+	assert.Equal(t, "u2", g.Sides[1].UserID)
+	assert.Equal(t, 10.0, g.Sides[1].Points)
+	assert.Equal(t, TradeGradeF, g.Sides[1].Grade)
+	assert.Equal(t, "Justin Jefferson, 2025 Round 1 pick", g.Sides[1].Received())
+
+	winner, ok := g.Winner()
+	require.True(t, ok)
+	assert.Equal(t, "u1", winner.UserID)
+	loser, ok := g.Loser()
+	require.True(t, ok)
+	assert.Equal(t, "u2", loser.UserID)
+	assert.Equal(t, 45.5, g.Margin())
+}
+
+func TestGradeTrades_NoPointsYet(t *testing.T) {
+	grades := GradeTrades(testTrades(), nil)
+
+	require.Len(t, grades, 1)
+	for _, side := range grades[0].Sides {
+		assert.Equal(t, TradeGradeUnknown, side.Grade)
+	}
+	_, ok := grades[0].Winner()
+	assert.False(t, ok)
+	assert.Nil(t, grades.Worst(), "a trade without a loser isn't the worst trade")
+}
+
+func TestTradeGrades_Worst(t *testing.T) {
+	grades := TradeGrades{
+		{Trade: Transaction{ID: "close"}, Sides: []TradeSide{{UserID: "u1", Points: 50}, {UserID: "u2", Points: 45}}},
+		{Trade: Transaction{ID: "lopsided"}, Sides: []TradeSide{{UserID: "u1", Points: 5}, {UserID: "u3", Points: 120}}},
+		{Trade: Transaction{ID: "tied"}, Sides: []TradeSide{{UserID: "u2", Points: 30}, {UserID: "u3", Points: 30}}},
+	}
+
+	worst := grades.Worst()
+	require.NotNil(t, worst)
+	assert.Equal(t, "lopsided", worst.Trade.ID)
+
+	assert.Len(t, grades.ForUser("u1"), 2)
+	assert.Len(t, grades.ForUser("u4"), 0)
+}
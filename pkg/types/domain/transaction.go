@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Transaction types, matching Sleeper's
+const (
+	TransactionTypeTrade     = "trade"
+	TransactionTypeWaiver    = "waiver"
+	TransactionTypeFreeAgent = "free_agent"
+)
+
+// Whether a player joined or left a roster in a transaction
+const (
+	TransactionActionAdd  = "ADD"
+	TransactionActionDrop = "DROP"
+)
+
+// Player is an NFL player
+type Player struct {
+	ID       string // Sleeper player ID
+	Name     string
+	Position string
+	Team     string // NFL team abbreviation, empty for free agents
+}
+
+// Description names the player with their position and team (e.g. "Ja'Marr Chase (WR, CIN)")
+func (p Player) Description() string {
+	switch {
+	case p.Position != "" && p.Team != "":
+		return fmt.Sprintf("%s (%s, %s)", p.Name, p.Position, p.Team)
+	case p.Position != "":
+		return fmt.Sprintf("%s (%s)", p.Name, p.Position)
+	}
+	return p.Name
+}
+
+// PlayerMap is players by Sleeper player ID
+type PlayerMap map[string]Player
+
+// Get returns a player by ID. Players that couldn't be found are named after their ID.
+func (pm PlayerMap) Get(id string) Player {
+	if p, ok := pm[id]; ok {
+		return p
+	}
+	return Player{ID: id, Name: id}
+}
+
+// Transaction is a trade, waiver claim or free agent move
+type Transaction struct {
+	ID         string // Sleeper transaction ID
+	Year       int
+	Week       int
+	Type       string
+	WaiverBid  int // FAAB bid for waiver claims, in dollars
+	CreatedAt  time.Time
+	Players    []TransactionPlayer
+	DraftPicks []TransactionDraftPick
+	FAABBudget int // League's FAAB budget, set for waiver claims in leagues that use FAAB
+	FAABUsed   int // FAAB the claiming team had spent this season after the claim, set along with FAABBudget
+}
+
+// TransactionPlayer is a player who joined or left a roster in a transaction
+type TransactionPlayer struct {
+	Player      Player
+	Action      string
+	FranchiseID int    // Sleeper roster ID the player joined or left
+	UserID      string // Sleeper user who owned the roster at the time
+}
+
+// TransactionDraftPick is a draft pick that changed hands in a trade
+type TransactionDraftPick struct {
+	Season              int
+	Round               int
+	OriginalFranchiseID int    // Sleeper roster ID the pick originally belonged to
+	OriginalUserID      string // Sleeper user who owned that roster at the time
+	FromUserID          string
+	ToUserID            string
+}
+
+// Description names the pick (e.g. "2026 Round 1 pick")
+func (p TransactionDraftPick) Description() string {
+	return fmt.Sprintf("%d Round %d pick", p.Season, p.Round)
+}
+
+type Transactions []Transaction
+
+// Adds returns the players who joined a roster in the transaction
+func (t Transaction) Adds() []TransactionPlayer {
+	return t.playersWithAction(TransactionActionAdd)
+}
+
+// Drops returns the players who left a roster in the transaction
+func (t Transaction) Drops() []TransactionPlayer {
+	return t.playersWithAction(TransactionActionDrop)
+}
+
+func (t Transaction) playersWithAction(action string) []TransactionPlayer {
+	var players []TransactionPlayer
+	for _, p := range t.Players {
+		if p.Action == action {
+			players = append(players, p)
+		}
+	}
+	return players
+}
+
+// UserIDs returns everyone involved in the transaction, in the order they first appear
+func (t Transaction) UserIDs() []string {
+	var ids []string
+	seen := make(map[string]bool)
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, p := range t.Players {
+		add(p.UserID)
+	}
+	for _, p := range t.DraftPicks {
+		add(p.ToUserID)
+		add(p.FromUserID)
+	}
+	return ids
+}
+
+// Received returns the players and draft picks a user received in the transaction
+func (t Transaction) Received(userID string) ([]TransactionPlayer, []TransactionDraftPick) {
+	var players []TransactionPlayer
+	for _, p := range t.Adds() {
+		if p.UserID == userID {
+			players = append(players, p)
+		}
+	}
+	var picks []TransactionDraftPick
+	for _, p := range t.DraftPicks {
+		if p.ToUserID == userID {
+			picks = append(picks, p)
+		}
+	}
+	return players, picks
+}
+
+// FAABRemaining returns the FAAB the claiming team has left, and whether the league uses FAAB
+func (t Transaction) FAABRemaining() (int, bool) {
+	if t.FAABBudget == 0 {
+		return 0, false
+	}
+	return t.FAABBudget - t.FAABUsed, true
+}
@@ -1,10 +1,11 @@
 package domain
 
 type User struct {
-	ID        string
-	Name      string
-	DiscordID string
-	Email     string
+	ID                 string
+	Name               string
+	DiscordID          string
+	Email              string
+	OnboardingComplete bool
 }
 
 type Users []User
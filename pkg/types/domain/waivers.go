@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Pickup is a player added off waivers or free agency
+type Pickup struct {
+	Year        int
+	Week        int
+	Type        string // TransactionTypeWaiver or TransactionTypeFreeAgent
+	Player      Player
+	FranchiseID int
+	UserID      string
+	Bid         int     // FAAB bid for waiver claims, in dollars
+	Points      float64 // Points the player scored in the team's starting lineup from the week they were added
+}
+
+// WaiverManager is how a manager used the waiver wire over a season
+type WaiverManager struct {
+	UserID     string
+	FAABSpent  int // FAAB spent over the season, from Sleeper
+	FAABBudget int // League's FAAB budget, 0 if the league doesn't use FAAB
+	Moves      int // Roster moves over the season, from Sleeper
+	Pickups    int // Players added off waivers or free agency
+}
+
+// WaiverReport is a season's waiver wire activity
+type WaiverReport struct {
+	Year          int
+	Managers      []WaiverManager // Most FAAB spent first, then most moves
+	MostExpensive *Pickup
+	BestPickup    *Pickup // Pickup that scored the most for the team that added them
+}
+
+// NewPickups returns every player added off waivers or free agency in a season's transactions, oldest first.
+// A pickup's points stop counting if the same team picks the player up again, so they aren't counted twice.
+func NewPickups(transactions Transactions, scores PlayerScores) []Pickup {
+	var pickups []Pickup
+	for _, t := range transactions {
+		if t.Type != TransactionTypeWaiver && t.Type != TransactionTypeFreeAgent {
+			continue
+		}
+		for _, p := range t.Adds() {
+			pickups = append(pickups, Pickup{
+				Year:        t.Year,
+				Week:        t.Week,
+				Type:        t.Type,
+				Player:      p.Player,
+				FranchiseID: p.FranchiseID,
+				UserID:      p.UserID,
+				Bid:         t.WaiverBid,
+			})
+		}
+	}
+
+	for idx, p := range pickups {
+		until := 0
+		for _, later := range pickups[idx+1:] {
+			if later.Player.ID == p.Player.ID && later.FranchiseID == p.FranchiseID && later.Week > p.Week {
+				until = later.Week
+				break
+			}
+		}
+		pickups[idx].Points = scores.StartedPointsBetween(p.Player.ID, p.FranchiseID, p.Week, until)
+	}
+	return pickups
+}
+
+// NewWaiverReport counts each manager's pickups and finds the season's most expensive and best pickups.
+// Ties go to the earlier pickup.
+func NewWaiverReport(year int, managers []WaiverManager, pickups []Pickup) WaiverReport {
+	report := WaiverReport{Year: year}
+
+	counts := make(map[string]int)
+	for idx, p := range pickups {
+		counts[p.UserID]++
+		if p.Bid > 0 && (report.MostExpensive == nil || p.Bid > report.MostExpensive.Bid) {
+			report.MostExpensive = &pickups[idx]
+		}
+		if p.Points > 0 && (report.BestPickup == nil || p.Points > report.BestPickup.Points) {
+			report.BestPickup = &pickups[idx]
+		}
+	}
+
+	for _, m := range managers {
+		m.Pickups = counts[m.UserID]
+		report.Managers = append(report.Managers, m)
+	}
+	sort.SliceStable(report.Managers, func(a, b int) bool {
+		ma, mb := report.Managers[a], report.Managers[b]
+		if ma.FAABSpent != mb.FAABSpent {
+			return ma.FAABSpent > mb.FAABSpent
+		}
+		return ma.Moves > mb.Moves
+	})
+
+	return report
+}
+
+// WaiverWireHeroAward goes to the pickup who scored the most in a starting lineup in a week.
+// Players picked up more than once by the same team are credited to the latest pickup, and ties go to the
+// more recent pickup. It returns nil if no pickup was started that week.
+func WaiverWireHeroAward(pickups []Pickup, scores PlayerScores, week int) *WeeklyAward {
+	var hero *WeeklyAward
+	seen := make(map[string]bool)
+	for idx := len(pickups) - 1; idx >= 0; idx-- {
+		p := pickups[idx]
+		key := fmt.Sprintf("%s:%d", p.Player.ID, p.FranchiseID)
+		if p.Week > week || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		points, ok := scores.StartedPointsInWeek(p.Player.ID, p.FranchiseID, week)
+		if !ok || (hero != nil && points <= hero.Score) {
+			continue
+		}
+
+		detail := fmt.Sprintf("%s, picked up in Week %d", p.Player.Description(), p.Week)
+		if p.Bid > 0 {
+			detail += fmt.Sprintf(" for $%d", p.Bid)
+		}
+		hero = &WeeklyAward{
+			Name:   "Waiver Wire Hero",
+			Emoji:  "🦸",
+			UserID: p.UserID,
+			Score:  points,
+			Detail: detail,
+		}
+	}
+	return hero
+}
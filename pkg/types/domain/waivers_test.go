@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPickups() ([]Pickup, PlayerScores) {
+	p1 := Player{ID: "p1", Name: "p1"}
+	transactions := Transactions{
+		{ID: "trade1", Year: 2024, Week: 2, Type: TransactionTypeTrade, Players: []TransactionPlayer{
+			{Player: Player{ID: "traded", Name: "traded"}, Action: TransactionActionAdd, FranchiseID: 2, UserID: "u2"},
+		}},
+		{ID: "waiver1", Year: 2024, Week: 2, Type: TransactionTypeWaiver, WaiverBid: 44, Players: []TransactionPlayer{
+			{Player: p1, Action: TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+		}},
+		{ID: "fa1", Year: 2024, Week: 3, Type: TransactionTypeFreeAgent, Players: []TransactionPlayer{
+			{Player: Player{ID: "p2", Name: "Puka Nacua", Position: "WR", Team: "LAR"}, Action: TransactionActionAdd, FranchiseID: 2, UserID: "u2"},
+		}},
+		{ID: "waiver2", Year: 2024, Week: 5, Type: TransactionTypeWaiver, WaiverBid: 3, Players: []TransactionPlayer{
+			{Player: p1, Action: TransactionActionAdd, FranchiseID: 1, UserID: "u1"},
+		}},
+	}
+
+	scores := PlayerScores{
+		{Week: 2, PlayerID: "p1", FranchiseID: 1, Points: 10, Started: true},
+		{Week: 3, PlayerID: "p1", FranchiseID: 1, Points: 12, Started: true},
+		{Week: 3, PlayerID: "p2", FranchiseID: 2, Points: 25, Started: true},
+		{Week: 4, PlayerID: "p2", FranchiseID: 2, Points: 30, Started: false},
+		{Week: 5, PlayerID: "p1", FranchiseID: 1, Points: 8, Started: true},
+		{Week: 5, PlayerID: "traded", FranchiseID: 2, Points: 40, Started: true},
+	}
+
+	return NewPickups(transactions, scores), scores
+}
+
+func TestNewPickups(t *testing.T) {
+	pickups, _ := testPickups()
+
+	require.Len(t, pickups, 3, "players received in trades aren't pickups")
+	assert.Equal(t, 22.0, pickups[0].Points, "points stop counting when the same team picks the player up again")
+	assert.Equal(t, 44, pickups[0].Bid)
+	assert.Equal(t, 25.0, pickups[1].Points, "bench points don't count")
+	assert.Equal(t, 8.0, pickups[2].Points)
+}
+
+func TestNewWaiverReport(t *testing.T) {
+	pickups, _ := testPickups()
+	managers := []WaiverManager{
+		{UserID: "u2", FAABSpent: 0, FAABBudget: 100, Moves: 4},
+		{UserID: "u1", FAABSpent: 47, FAABBudget: 100, Moves: 2},
+		{UserID: "u3", FAABSpent: 0, FAABBudget: 100, Moves: 9},
+	}
+
+	report := NewWaiverReport(2024, managers, pickups)
+
+	require.Len(t, report.Managers, 3)
+	assert.Equal(t, []string{"u1", "u3", "u2"}, []string{report.Managers[0].UserID, report.Managers[1].UserID, report.Managers[2].UserID})
+	assert.Equal(t, 2, report.Managers[0].Pickups)
+	assert.Equal(t, 0, report.Managers[1].Pickups)
+
+	require.NotNil(t, report.MostExpensive)
+	assert.Equal(t, 44, report.MostExpensive.Bid)
+	require.NotNil(t, report.BestPickup)
+	assert.Equal(t, "p2", report.BestPickup.Player.ID)
+}
+
+func TestWaiverWireHeroAward(t *testing.T) {
+	pickups, scores := testPickups()
+
+	hero := WaiverWireHeroAward(pickups, scores, 3)
+	require.NotNil(t, hero)
+	assert.Equal(t, "u2", hero.UserID)
+	assert.Equal(t, 25.0, hero.Score)
+	assert.Equal(t, "Puka Nacua (WR, LAR), picked up in Week 3", hero.Detail)
+
+	hero = WaiverWireHeroAward(pickups, scores, 5)
+	require.NotNil(t, hero)
+	assert.Equal(t, "u1", hero.UserID, "players received in trades aren't eligible")
+	assert.Equal(t, "p1, picked up in Week 5 for $3", hero.Detail, "players picked up again are credited to the latest pickup")
+
+	assert.Nil(t, WaiverWireHeroAward(pickups, scores, 4), "nobody started a pickup in week 4")
+}
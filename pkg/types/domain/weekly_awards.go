@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// WeeklyAward is a single award for one week's games. OpponentID and OpponentScore are only set for
+// awards given for a head-to-head result.
+type WeeklyAward struct {
+	Name          string
+	Emoji         string
+	UserID        string
+	Score         float64
+	OpponentID    string
+	OpponentScore float64
+	Detail        string // Extra context, e.g. the winning margin
+}
+
+// Description renders the award's result with user names, e.g. "Alice (140.00) vs Bob (70.00) - won by 70.00".
+func (a WeeklyAward) Description(users UserMap) string {
+	name := func(userID string) string {
+		if user, ok := users[userID]; ok && user.Name != "" {
+			return user.Name
+		}
+		return userID
+	}
+
+	var description string
+	if a.OpponentID != "" {
+		description = fmt.Sprintf("%s (%.2f) vs %s (%.2f)", name(a.UserID), a.Score, name(a.OpponentID), a.OpponentScore)
+	} else {
+		description = fmt.Sprintf("%s - %.2f points", name(a.UserID), a.Score)
+	}
+
+	if a.Detail != "" {
+		description += " - " + a.Detail
+	}
+	return description
+}
+
+type WeeklyAwards []WeeklyAward
+
+// WeeklyAwardFunc calculates a single award from one week's matchups.
+// It returns nil when no team qualifies for the award that week.
+type WeeklyAwardFunc func(week Matchups) *WeeklyAward
+
+// DefaultWeeklyAwards are the awards included in every weekly summary, in display order.
+var DefaultWeeklyAwards = []WeeklyAwardFunc{
+	LowestScoreAward,
+	BiggestBlowoutAward,
+	ClosestGameAward,
+	LuckyWinAward,
+	UnluckyLossAward,
+}
+
+// CalculateWeeklyAwards runs each award calculation against one week's matchups, skipping awards nobody won.
+func CalculateWeeklyAwards(week Matchups, awardFuncs ...WeeklyAwardFunc) WeeklyAwards {
+	var awards WeeklyAwards
+	for _, calculate := range awardFuncs {
+		if award := calculate(week); award != nil {
+			awards = append(awards, *award)
+		}
+	}
+	return awards
+}
+
+// LowestScoreAward goes to the lowest scoring team of the week.
+func LowestScoreAward(week Matchups) *WeeklyAward {
+	var award *WeeklyAward
+	for _, team := range teamScores(week) {
+		if award == nil || team.score < award.Score {
+			award = &WeeklyAward{Name: "Lowest Score", Emoji: "🐢", UserID: team.userID, Score: team.score}
+		}
+	}
+	return award
+}
+
+// BiggestBlowoutAward goes to the winner of the game with the largest margin.
+func BiggestBlowoutAward(week Matchups) *WeeklyAward {
+	var blowout *Matchup
+	for idx, m := range week {
+		if m.Winner() == "" {
+			continue
+		}
+		if blowout == nil || m.Margin() > blowout.Margin() {
+			blowout = &week[idx]
+		}
+	}
+	if blowout == nil {
+		return nil
+	}
+
+	award := headToHeadAward("Biggest Blowout", "💥", *blowout, blowout.Winner())
+	award.Detail = fmt.Sprintf("won by %.2f", blowout.Margin())
+	return &award
+}
+
+// ClosestGameAward goes to the winner of the game with the smallest margin.
+func ClosestGameAward(week Matchups) *WeeklyAward {
+	var closest *Matchup
+	for idx, m := range week {
+		if m.Winner() == "" {
+			continue
+		}
+		if closest == nil || m.Margin() < closest.Margin() {
+			closest = &week[idx]
+		}
+	}
+	if closest == nil {
+		return nil
+	}
+
+	award := headToHeadAward("Closest Game", "😅", *closest, closest.Winner())
+	award.Detail = fmt.Sprintf("won by %.2f", closest.Margin())
+	return &award
+}
+
+// LuckyWinAward goes to the lowest scoring team that won with a score below the week's median.
+func LuckyWinAward(week Matchups) *WeeklyAward {
+	median := medianScore(week)
+
+	var lucky *WeeklyAward
+	for _, m := range week {
+		winner := m.Winner()
+		if winner == "" {
+			continue
+		}
+		award := headToHeadAward("Lucky Win", "🍀", m, winner)
+		if award.Score < median && (lucky == nil || award.Score < lucky.Score) {
+			award.Detail = fmt.Sprintf("won below the %.2f median", median)
+			lucky = &award
+		}
+	}
+	return lucky
+}
+
+// UnluckyLossAward goes to the team with the week's second-highest score if they lost.
+func UnluckyLossAward(week Matchups) *WeeklyAward {
+	scores := teamScores(week)
+	if len(scores) < 2 {
+		return nil
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool { return scores[i].score > scores[j].score })
+	runnerUp := scores[1]
+
+	for _, m := range week {
+		if m.Loser() == runnerUp.userID {
+			award := headToHeadAward("Unlucky Loss", "💔", m, runnerUp.userID)
+			award.Detail = "lost with the second-highest score"
+			return &award
+		}
+	}
+	return nil
+}
+
+// headToHeadAward builds an award for one side of a matchup.
+func headToHeadAward(name, emoji string, m Matchup, userID string) WeeklyAward {
+	award := WeeklyAward{
+		Name:          name,
+		Emoji:         emoji,
+		UserID:        m.HomeUserID,
+		Score:         m.HomeScore,
+		OpponentID:    m.AwayUserID,
+		OpponentScore: m.AwayScore,
+	}
+	if userID == m.AwayUserID {
+		award.UserID, award.OpponentID = m.AwayUserID, m.HomeUserID
+		award.Score, award.OpponentScore = m.AwayScore, m.HomeScore
+	}
+	return award
+}
+
+type teamScore struct {
+	userID string
+	score  float64
+}
+
+// teamScores flattens a week's matchups into each team's score, in matchup order.
+func teamScores(week Matchups) []teamScore {
+	scores := make([]teamScore, 0, len(week)*2)
+	for _, m := range week {
+		scores = append(scores, teamScore{m.HomeUserID, m.HomeScore}, teamScore{m.AwayUserID, m.AwayScore})
+	}
+	return scores
+}
+
+// medianScore returns the median team score of the week.
+func medianScore(week Matchups) float64 {
+	scores := teamScores(week)
+	if len(scores) == 0 {
+		return 0
+	}
+
+	values := make([]float64, len(scores))
+	for idx, s := range scores {
+		values[idx] = s.score
+	}
+	slices.Sort(values)
+
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
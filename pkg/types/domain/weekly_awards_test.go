@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateWeeklyAwards(t *testing.T) {
+	week := Matchups{
+		{Week: 3, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 150.0, AwayScore: 140.0},
+		{Week: 3, HomeUserID: "user3", AwayUserID: "user4", HomeScore: 95.0, AwayScore: 60.0},
+		{Week: 3, HomeUserID: "user5", AwayUserID: "user6", HomeScore: 101.0, AwayScore: 100.0},
+	}
+
+	awards := CalculateWeeklyAwards(week, DefaultWeeklyAwards...)
+
+	byName := make(map[string]WeeklyAward)
+	for _, award := range awards {
+		byName[award.Name] = award
+	}
+	assert.Len(t, byName, 5)
+
+	assert.Equal(t, "user4", byName["Lowest Score"].UserID)
+	assert.Equal(t, "user3", byName["Biggest Blowout"].UserID)
+	assert.Equal(t, "won by 35.00", byName["Biggest Blowout"].Detail)
+	assert.Equal(t, "user5", byName["Closest Game"].UserID)
+	assert.Equal(t, "user3", byName["Lucky Win"].UserID, "95 is below the 100.50 median")
+	assert.Equal(t, "user2", byName["Unlucky Loss"].UserID)
+	assert.Equal(t, "user1", byName["Unlucky Loss"].OpponentID)
+
+	users := UserMap{"user2": {ID: "user2", Name: "Bob"}, "user1": {ID: "user1", Name: "Alice"}}
+	assert.Equal(t, "Bob (140.00) vs Alice (150.00) - lost with the second-highest score", byName["Unlucky Loss"].Description(users))
+}
+
+func TestCalculateWeeklyAwards_NoQualifiers(t *testing.T) {
+	// The second-highest scorer won, and nobody won with a below-median score
+	week := Matchups{
+		{Week: 1, HomeUserID: "user1", AwayUserID: "user2", HomeScore: 150.0, AwayScore: 80.0},
+		{Week: 1, HomeUserID: "user3", AwayUserID: "user4", HomeScore: 140.0, AwayScore: 90.0},
+	}
+
+	awards := CalculateWeeklyAwards(week, LuckyWinAward, UnluckyLossAward)
+	assert.Empty(t, awards)
+	assert.Empty(t, CalculateWeeklyAwards(Matchups{}, DefaultWeeklyAwards...))
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Members who haven't linked their Sleeper account are reminded every OnboardingReminderInterval,
+// at most MaxOnboardingReminders times
+const (
+	OnboardingReminderInterval = 48 * time.Hour
+	MaxOnboardingReminders     = 3
+)
+
+// WelcomeMessage is the welcome message posted for a Discord user who hasn't linked their Sleeper account yet.
+type WelcomeMessage struct {
+	DiscordID      string
+	ChannelID      string
+	MessageID      string
+	ReminderCount  int
+	LastRemindedAt time.Time // Zero if the user hasn't been reminded yet
+	CreatedAt      time.Time
+}
+
+type WelcomeMessages []WelcomeMessage
+
+// ReminderDue reports whether the user should be reminded to link their account at now
+func (m WelcomeMessage) ReminderDue(now time.Time) bool {
+	if m.ReminderCount >= MaxOnboardingReminders {
+		return false
+	}
+
+	since := m.CreatedAt
+	if !m.LastRemindedAt.IsZero() {
+		since = m.LastRemindedAt
+	}
+	// Reminders are sent by a daily job whose start time drifts, so allow an hour of slack
+	return now.Sub(since) >= OnboardingReminderInterval-time.Hour
+}
+
+// URL links to the welcome message in Discord
+func (m WelcomeMessage) URL(guildID string) string {
+	return fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, m.ChannelID, m.MessageID)
+}
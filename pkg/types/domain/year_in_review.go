@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// WeekScore is a single week's score for a user.
+type WeekScore struct {
+	Week  int
+	Score float64
+}
+
+// OpponentRecord is a user's head-to-head record against one opponent.
+type OpponentRecord struct {
+	OpponentID string
+	Wins       int
+	Losses     int
+}
+
+// YearInReview is a user's personalized season wrapped report.
+type YearInReview struct {
+	Year          int
+	UserID        string
+	Wins          int
+	Losses        int
+	Ties          int
+	PointsFor     float64
+	PointsAgainst float64
+	Ranks         []int // Standings rank after each regular season week
+	Placement     int   // Final placement for the podium (1-3) once the league is complete, otherwise 0
+	BestWeek      *WeekScore
+	WorstWeek     *WeekScore
+	MostBeaten    *OpponentRecord // Opponent the user beat the most
+	Nemesis       *OpponentRecord // Opponent the user lost to the most
+	BenchPoints   float64
+	NetWinnings   int
+}
+
+// FinalRank returns the user's standings rank at the end of the regular season, or 0 if unknown.
+func (r YearInReview) FinalRank() int {
+	if len(r.Ranks) == 0 {
+		return 0
+	}
+	return r.Ranks[len(r.Ranks)-1]
+}
+
+// Finish describes where the user finished: their podium placement if they made it, otherwise their
+// regular season rank, since the playoffs decide the final order.
+func (r YearInReview) Finish() string {
+	if r.Placement > 0 {
+		return fmt.Sprintf("finished #%d", r.Placement)
+	}
+	if rank := r.FinalRank(); rank > 0 {
+		return fmt.Sprintf("#%d in the regular season", rank)
+	}
+	return ""
+}
+
+type YearInReviews []YearInReview
+
+// NewYearInReviews builds a year-in-review for every user who played a regular season game, ordered by
+// final regular season rank. Bench points and winnings aren't derivable from matchups and are left unset.
+func NewYearInReviews(year int, matchups Matchups) YearInReviews {
+	reviews := make(map[string]*YearInReview)
+	h2h := make(map[string]map[string]*OpponentRecord)
+	var order []string
+	var weeks []int
+	byWeek := make(map[int]Matchups)
+
+	for _, m := range matchups {
+		if m.IsPlayoff {
+			continue
+		}
+
+		if _, ok := byWeek[m.Week]; !ok {
+			weeks = append(weeks, m.Week)
+		}
+		byWeek[m.Week] = append(byWeek[m.Week], m)
+
+		sides := []struct {
+			userID, opponentID   string
+			score, opponentScore float64
+		}{
+			{m.HomeUserID, m.AwayUserID, m.HomeScore, m.AwayScore},
+			{m.AwayUserID, m.HomeUserID, m.AwayScore, m.HomeScore},
+		}
+		for _, side := range sides {
+			r, ok := reviews[side.userID]
+			if !ok {
+				r = &YearInReview{Year: year, UserID: side.userID}
+				reviews[side.userID] = r
+				h2h[side.userID] = make(map[string]*OpponentRecord)
+				order = append(order, side.userID)
+			}
+
+			r.PointsFor += side.score
+			r.PointsAgainst += side.opponentScore
+
+			week := WeekScore{Week: m.Week, Score: side.score}
+			if r.BestWeek == nil || week.Score > r.BestWeek.Score {
+				r.BestWeek = &week
+			}
+			if r.WorstWeek == nil || week.Score < r.WorstWeek.Score {
+				r.WorstWeek = &week
+			}
+
+			record, ok := h2h[side.userID][side.opponentID]
+			if !ok {
+				record = &OpponentRecord{OpponentID: side.opponentID}
+				h2h[side.userID][side.opponentID] = record
+			}
+
+			switch {
+			case side.score > side.opponentScore:
+				r.Wins++
+				record.Wins++
+			case side.score < side.opponentScore:
+				r.Losses++
+				record.Losses++
+			default:
+				r.Ties++
+			}
+		}
+	}
+
+	// Rank trajectory: the standings after each week, counting every game played so far
+	sort.Ints(weeks)
+	var played Matchups
+	for _, week := range weeks {
+		played = append(played, byWeek[week]...)
+		for rank, standing := range MatchupsToStandingsMap(played).SortStandingsMap() {
+			reviews[standing.UserID].Ranks = append(reviews[standing.UserID].Ranks, rank+1)
+		}
+	}
+
+	result := make(YearInReviews, 0, len(order))
+	for _, userID := range order {
+		r := reviews[userID]
+		for _, opponentID := range sortedKeys(h2h[userID]) {
+			record := h2h[userID][opponentID]
+			if record.Wins > 0 && (r.MostBeaten == nil || record.Wins > r.MostBeaten.Wins) {
+				r.MostBeaten = record
+			}
+			if record.Losses > 0 && (r.Nemesis == nil || record.Losses > r.Nemesis.Losses) {
+				r.Nemesis = record
+			}
+		}
+		result = append(result, *r)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].FinalRank() < result[j].FinalRank()
+	})
+
+	return result
+}
+
+// sortedKeys returns the opponent IDs in a stable order so ties are broken the same way every time.
+func sortedKeys(records map[string]*OpponentRecord) []string {
+	keys := make([]string, 0, len(records))
+	for k := range records {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (r YearInReview) ToDiscordMessage(users UserMap) string {
+	var b strings.Builder
+
+	name := func(userID string) string {
+		if user, ok := users[userID]; ok && user.Name != "" {
+			return user.Name
+		}
+		return userID
+	}
+
+	fmt.Fprintf(&b, "**🎁 %s's %d Year in Review 🎁**\n\n", name(r.UserID), r.Year)
+
+	fmt.Fprintf(&b, "📋 **Record:** %d-%d-%d", r.Wins, r.Losses, r.Ties)
+	if finish := r.Finish(); finish != "" {
+		fmt.Fprintf(&b, " (%s)", finish)
+	}
+	fmt.Fprintf(&b, "\n📈 **Points For:** %.2f | **Points Against:** %.2f\n", r.PointsFor, r.PointsAgainst)
+
+	if len(r.Ranks) > 0 {
+		ranks := make([]string, len(r.Ranks))
+		for i, rank := range r.Ranks {
+			ranks[i] = fmt.Sprintf("%d", rank)
+		}
+		fmt.Fprintf(&b, "🎢 **Rank by Week:** %s\n", strings.Join(ranks, " → "))
+	}
+
+	if r.BestWeek != nil {
+		fmt.Fprintf(&b, "🔥 **Best Week:** Week %d - %.2f points\n", r.BestWeek.Week, r.BestWeek.Score)
+	}
+	if r.WorstWeek != nil {
+		fmt.Fprintf(&b, "🧊 **Worst Week:** Week %d - %.2f points\n", r.WorstWeek.Week, r.WorstWeek.Score)
+	}
+	if r.MostBeaten != nil {
+		fmt.Fprintf(&b, "😈 **Favorite Victim:** %s (%d-%d)\n", name(r.MostBeaten.OpponentID), r.MostBeaten.Wins, r.MostBeaten.Losses)
+	}
+	if r.Nemesis != nil {
+		fmt.Fprintf(&b, "👹 **Nemesis:** %s (%d-%d)\n", name(r.Nemesis.OpponentID), r.Nemesis.Wins, r.Nemesis.Losses)
+	}
+
+	fmt.Fprintf(&b, "🪑 **Points Left on Bench:** %.2f\n", r.BenchPoints)
+	fmt.Fprintf(&b, "💰 **Money Won/Lost:** %s\n", FormatDollars(r.NetWinnings))
+
+	return b.String()
+}